@@ -6,8 +6,9 @@ import (
 	"time"
 )
 
-// ANSI color codes
-const (
+// ANSI color codes. These are cleared by disableColors when output is not
+// going to a terminal.
+var (
 	colorReset   = "\033[0m"
 	colorRed     = "\033[31m"
 	colorGreen   = "\033[32m"
@@ -21,6 +22,18 @@ const (
 // Initialize random number generator
 var rnd = rand.New(rand.NewSource(time.Now().UnixNano()))
 
+// disableColors turns all ANSI color codes into empty strings
+func disableColors() {
+	colorReset = ""
+	colorRed = ""
+	colorGreen = ""
+	colorYellow = ""
+	colorBlue = ""
+	colorMagenta = ""
+	colorCyan = ""
+	colorBold = ""
+}
+
 // Run executes the main application logic
 func Run(args []string) error {
 	return nil
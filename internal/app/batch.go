@@ -0,0 +1,359 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// batchSampleSize is how many matching workflows are shown before confirming
+const batchSampleSize = 5
+
+// batchOperation applies a batch action to a single workflow run
+type batchOperation func(ctx context.Context, temporalClient client.Client, execution *common.WorkflowExecution) error
+
+// batchFailure records a workflow the batch action failed for
+type batchFailure struct {
+	WorkflowID string `json:"workflowId" yaml:"workflowId"`
+	RunID      string `json:"runId" yaml:"runId"`
+	Error      string `json:"error" yaml:"error"`
+}
+
+// batchResult is the structured output of the batch commands
+type batchResult struct {
+	Operation   string         `json:"operation" yaml:"operation"`
+	Query       string         `json:"query" yaml:"query"`
+	Total       int            `json:"total" yaml:"total"`
+	Succeeded   int            `json:"succeeded" yaml:"succeeded"`
+	Failed      int            `json:"failed" yaml:"failed"`
+	Interrupted bool           `json:"interrupted,omitempty" yaml:"interrupted,omitempty"`
+	Failures    []batchFailure `json:"failures,omitempty" yaml:"failures,omitempty"`
+}
+
+// batchFlags returns the flags shared by every batch subcommand
+func batchFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:     "query",
+			Usage:    "Visibility query selecting the workflows to act on",
+			Required: true,
+		},
+		&cli.IntFlag{
+			Name:    "concurrency",
+			Aliases: []string{"c"},
+			Usage:   "Number of workflows to act on in parallel",
+			Value:   10,
+		},
+		&cli.Float64Flag{
+			Name:  "rps",
+			Usage: "Maximum number of requests per second (0 for no limit)",
+		},
+		&cli.BoolFlag{
+			Name:    "yes",
+			Aliases: []string{"y"},
+			Usage:   "Skip the confirmation prompt",
+		},
+	}
+}
+
+// newBatchCommand creates the command group for acting on many workflows at once
+func newBatchCommand(config *TemporalConfig) *cli.Command {
+	return &cli.Command{
+		Name:  "batch",
+		Usage: "Signal, cancel or terminate every workflow matching a query",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "signal",
+				Usage: "Signal every workflow matching a query",
+				Flags: append([]cli.Flag{
+					&cli.StringFlag{
+						Name:     "signal-name",
+						Aliases:  []string{"s"},
+						Usage:    "Name of the signal to send",
+						Required: true,
+					},
+					&cli.StringSliceFlag{
+						Name:    "input",
+						Aliases: []string{"i"},
+						Usage:   "JSON input for the signal; repeat for multiple arguments (\"-\" reads from stdin)",
+						Value:   cli.NewStringSlice("{}"),
+					},
+					&cli.BoolFlag{
+						Name:  "input-args",
+						Usage: "Treat the input as a JSON array whose elements are passed as separate arguments",
+					},
+				}, batchFlags()...),
+				Action: func(c *cli.Context) error {
+					return batchSignal(c, *config)
+				},
+			},
+			{
+				Name:  "cancel",
+				Usage: "Request cancellation of every workflow matching a query",
+				Flags: batchFlags(),
+				Action: func(c *cli.Context) error {
+					return runBatch(c, *config, "cancel",
+						func(ctx context.Context, temporalClient client.Client, execution *common.WorkflowExecution) error {
+							return temporalClient.CancelWorkflow(ctx, execution.WorkflowId, execution.RunId)
+						})
+				},
+			},
+			{
+				Name:  "terminate",
+				Usage: "Terminate every workflow matching a query",
+				Flags: append([]cli.Flag{
+					&cli.StringFlag{
+						Name:  "reason",
+						Usage: "Reason for terminating, recorded in each workflow's history",
+					},
+					&cli.StringFlag{
+						Name:  "details",
+						Usage: "JSON details recorded with each termination (\"-\" reads from stdin)",
+					},
+				}, batchFlags()...),
+				Action: func(c *cli.Context) error {
+					return batchTerminate(c, *config)
+				},
+			},
+		},
+	}
+}
+
+// batchSignal sends the same signal to every matching workflow
+func batchSignal(c *cli.Context, config TemporalConfig) error {
+	args, err := parseInputArgs(config, c.StringSlice("input"), c.Bool("input-args"), "input")
+	if err != nil {
+		return err
+	}
+	signalName := c.String("signal-name")
+
+	return runBatch(c, config, "signal",
+		func(ctx context.Context, temporalClient client.Client, execution *common.WorkflowExecution) error {
+			return signalWithArgs(ctx, temporalClient, config, execution.WorkflowId, execution.RunId, signalName, args)
+		})
+}
+
+// batchTerminate terminates every matching workflow with the same reason and details
+func batchTerminate(c *cli.Context, config TemporalConfig) error {
+	var details []interface{}
+	if c.IsSet("details") {
+		var err error
+		details, err = parseInputArgs(config, []string{c.String("details")}, false, "details")
+		if err != nil {
+			return err
+		}
+	}
+	reason := c.String("reason")
+
+	return runBatch(c, config, "terminate",
+		func(ctx context.Context, temporalClient client.Client, execution *common.WorkflowExecution) error {
+			return temporalClient.TerminateWorkflow(ctx, execution.WorkflowId, execution.RunId, reason, details...)
+		})
+}
+
+// runBatch finds the workflows matching --query, confirms with the user, and
+// applies op to each of them from a pool of workers
+func runBatch(c *cli.Context, config TemporalConfig, operation string, op batchOperation) error {
+	query := c.String("query")
+	concurrency := c.Int("concurrency")
+	if concurrency < 1 {
+		return fmt.Errorf("--concurrency must be at least 1")
+	}
+	if c.Float64("rps") < 0 {
+		return fmt.Errorf("--rps must not be negative")
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Ctrl-C stops dispatching new work; workflows already in flight finish
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	executions, err := previewBatch(ctx, c, config, temporalClient, operation, query)
+	if err != nil || executions == nil {
+		return err
+	}
+
+	result := batchResult{
+		Operation: operation,
+		Query:     query,
+		Total:     len(executions),
+	}
+
+	jobs := make(chan *common.WorkflowExecution)
+	// Each job reports a failure, or nil if it succeeded
+	outcomes := make(chan *batchFailure)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for execution := range jobs {
+				opCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				err := op(opCtx, temporalClient, execution)
+				cancel()
+
+				var failure *batchFailure
+				if err != nil {
+					failure = &batchFailure{
+						WorkflowID: execution.WorkflowId,
+						RunID:      execution.RunId,
+						Error:      err.Error(),
+					}
+				}
+				outcomes <- failure
+			}
+		}()
+	}
+
+	// Dispatch jobs, pacing them when a rate limit is set
+	go func() {
+		defer close(jobs)
+
+		var tick <-chan time.Time
+		if rps := c.Float64("rps"); rps > 0 {
+			ticker := time.NewTicker(time.Duration(float64(time.Second) / rps))
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+
+		for _, execution := range executions {
+			if tick != nil {
+				select {
+				case <-tick:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case jobs <- execution:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(outcomes)
+	}()
+
+	// The progress line is redrawn in place, so only show it on a terminal
+	showProgress := !isStructuredOutput(config) && stdoutIsTerminal()
+	lineStart := ""
+	if showProgress {
+		lineStart = "\r\033[K"
+	}
+
+	for failure := range outcomes {
+		if failure == nil {
+			result.Succeeded++
+		} else {
+			result.Failed++
+			result.Failures = append(result.Failures, *failure)
+			if !isStructuredOutput(config) {
+				fmt.Printf("%s%sFailed%s %s (run %s): %s\n",
+					lineStart, colorRed, colorReset, failure.WorkflowID, failure.RunID, failure.Error)
+			}
+		}
+
+		if showProgress {
+			fmt.Printf("%sProcessed %d/%d (%d failed)", lineStart, result.Succeeded+result.Failed, result.Total, result.Failed)
+		}
+	}
+	if showProgress {
+		fmt.Println()
+	}
+
+	result.Interrupted = ctx.Err() != nil
+
+	if isStructuredOutput(config) {
+		if err := printStructured(config, result); err != nil {
+			return err
+		}
+	} else {
+		if result.Interrupted {
+			fmt.Printf("%sInterrupted;%s %d workflows were not processed\n",
+				colorYellow, colorReset, result.Total-result.Succeeded-result.Failed)
+		}
+		fmt.Printf("%sBatch %s finished:%s %d succeeded, %d failed\n",
+			colorBold, operation, colorReset, result.Succeeded, result.Failed)
+	}
+
+	if result.Failed > 0 {
+		return fmt.Errorf("batch %s failed for %d of %d workflows", operation, result.Failed, result.Total)
+	}
+	if result.Interrupted {
+		return fmt.Errorf("batch %s interrupted", operation)
+	}
+	return nil
+}
+
+// previewBatch shows how many workflows match the query and a sample of them,
+// asks for confirmation, and returns the workflows to act on. It returns no
+// workflows and no error if nothing matches.
+func previewBatch(ctx context.Context, c *cli.Context, config TemporalConfig, temporalClient client.Client,
+	operation, query string) ([]*common.WorkflowExecution, error) {
+	listCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	countResp, err := temporalClient.CountWorkflow(listCtx, &workflowservice.CountWorkflowExecutionsRequest{
+		Query: query,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count workflows: %w", err)
+	}
+
+	if countResp.GetCount() == 0 {
+		infof(config, "No workflows match the query\n")
+		return nil, nil
+	}
+
+	sample, _, err := listWorkflowExecutions(listCtx, temporalClient, query, batchSampleSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+
+	infof(config, "%d workflows match %s%s%s\n", countResp.GetCount(), colorBold, query, colorReset)
+	for _, execution := range sample {
+		infof(config, "  %s (%s, %s)\n",
+			execution.Execution.WorkflowId,
+			execution.Type.Name,
+			enums.WorkflowExecutionStatus_name[int32(execution.Status)],
+		)
+	}
+	if countResp.GetCount() > int64(len(sample)) {
+		infof(config, "  ...and %d more\n", countResp.GetCount()-int64(len(sample)))
+	}
+
+	if !c.Bool("yes") && !confirmAction(fmt.Sprintf("Run batch %s on %d workflows", operation, countResp.GetCount())) {
+		return nil, fmt.Errorf("batch %s aborted by user", operation)
+	}
+
+	// Collect every target up front, since acting on workflows can change
+	// which ones match the query while we page through it
+	all, _, err := listWorkflowExecutions(ctx, temporalClient, query, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+
+	executions := make([]*common.WorkflowExecution, 0, len(all))
+	for _, execution := range all {
+		executions = append(executions, execution.Execution)
+	}
+	return executions, nil
+}
@@ -0,0 +1,853 @@
+package app
+
+import (
+	"fmt"
+	"go/format"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Languages infer-params can emit type definitions in
+const (
+	emitGo         = "go"
+	emitTypeScript = "typescript"
+	emitPython     = "python"
+	emitPydantic   = "pydantic"
+	emitProto      = "proto"
+)
+
+// emitLanguages lists the accepted --emit values
+var emitLanguages = []string{emitGo, emitTypeScript, emitPython, emitPydantic, emitProto}
+
+// emitAliases are shorter names accepted for some languages
+var emitAliases = map[string]string{
+	"golang":   emitGo,
+	"ts":       emitTypeScript,
+	"py":       emitPython,
+	"protobuf": emitProto,
+}
+
+// namedSchema is an inferred schema and the name of the type generated from it
+type namedSchema struct {
+	Name   string
+	Schema map[string]interface{}
+}
+
+// codegenType is a type resolved from a JSON schema, ready to be written in
+// any of the languages
+type codegenType struct {
+	// Kind is object, array, string, integer, number, boolean or any
+	Kind string
+	// Name is set for objects and enums, which get a definition of their own
+	Name   string
+	Format string
+	Enum   []string
+	Items  *codegenType
+	Fields []codegenField
+	// Nullable is set when null was seen as well as values of the type
+	Nullable bool
+	// TopLevel is set for the types of whole payloads, rather than of fields
+	TopLevel bool
+}
+
+// codegenField is a property of an object type
+type codegenField struct {
+	JSONName string
+	Required bool
+	Type     *codegenType
+}
+
+// codegenModel collects the named types of a set of schemas. Definitions are
+// in dependency order, so a type is defined after the types it refers to.
+type codegenModel struct {
+	definitions []*codegenType
+	names       map[string]bool
+}
+
+// newCodegenModel resolves the types of every schema. Schemas with several
+// alternative structures get one type per alternative.
+func newCodegenModel(schemas []namedSchema) *codegenModel {
+	model := &codegenModel{names: make(map[string]bool)}
+	for _, named := range schemas {
+		name := pascalCase(splitWords(named.Name))
+		if oneOf, ok := named.Schema["oneOf"].([]interface{}); ok {
+			for i, variant := range oneOf {
+				if variantSchema, ok := variant.(map[string]interface{}); ok {
+					model.resolve(variantSchema, fmt.Sprintf("%sVariant%d", name, i+1), true)
+				}
+			}
+			continue
+		}
+		model.resolve(named.Schema, name, true)
+	}
+	return model
+}
+
+// uniqueName returns name, numbered if another type already has it. Names
+// starting with a digit are prefixed, since no language allows them.
+func (m *codegenModel) uniqueName(name string) string {
+	if name == "" {
+		name = "Value"
+	}
+	if unicode.IsDigit(rune(name[0])) {
+		name = "N" + name
+	}
+	unique := name
+	for i := 2; m.names[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	m.names[unique] = true
+	return unique
+}
+
+// resolve converts a schema into a type named after name. Top-level schemas
+// always get a definition, even when they aren't objects.
+func (m *codegenModel) resolve(schema map[string]interface{}, name string, topLevel bool) *codegenType {
+	valueType, nullable := schemaValueType(schema)
+	t := &codegenType{Kind: valueType, Nullable: nullable, TopLevel: topLevel}
+
+	switch valueType {
+	case "object":
+		t.Name = m.uniqueName(name)
+		properties, _ := schema["properties"].(map[string]interface{})
+		required := schemaRequired(schema)
+
+		jsonNames := make([]string, 0, len(properties))
+		for jsonName := range properties {
+			jsonNames = append(jsonNames, jsonName)
+		}
+		sort.Strings(jsonNames)
+
+		for _, jsonName := range jsonNames {
+			propertySchema, _ := properties[jsonName].(map[string]interface{})
+			t.Fields = append(t.Fields, codegenField{
+				JSONName: jsonName,
+				Required: required[jsonName],
+				Type:     m.resolve(propertySchema, name+pascalCase(splitWords(jsonName)), false),
+			})
+		}
+		m.definitions = append(m.definitions, t)
+		return t
+
+	case "array":
+		items, _ := schema["items"].(map[string]interface{})
+		t.Items = m.resolve(items, singular(name), false)
+
+	case "string":
+		t.Format, _ = schema["format"].(string)
+		if enum, ok := schema["enum"].([]interface{}); ok {
+			for _, value := range enum {
+				if s, ok := value.(string); ok {
+					t.Enum = append(t.Enum, s)
+				}
+			}
+		}
+		if len(t.Enum) > 0 {
+			t.Name = m.uniqueName(name)
+			m.definitions = append(m.definitions, t)
+			return t
+		}
+
+	case "integer", "number", "boolean":
+
+	default:
+		t.Kind = "any"
+	}
+
+	// Give non-object top-level types a name through an alias
+	if topLevel {
+		alias := &codegenType{Kind: "alias", Name: m.uniqueName(name), Items: t}
+		m.definitions = append(m.definitions, alias)
+		return alias
+	}
+	return t
+}
+
+// generateCode writes type definitions for schemas in a language
+func generateCode(language, packageName string, schemas []namedSchema) (string, error) {
+	model := newCodegenModel(schemas)
+
+	switch language {
+	case emitGo:
+		return generateGo(model, packageName)
+	case emitTypeScript:
+		return generateTypeScript(model), nil
+	case emitPython:
+		return generatePython(model, false), nil
+	case emitPydantic:
+		return generatePython(model, true), nil
+	case emitProto:
+		return generateProto(model, packageName), nil
+	default:
+		return "", fmt.Errorf("unknown language '%s' (valid languages: %s)", language, strings.Join(emitLanguages, ", "))
+	}
+}
+
+// emitInferredTypes prints type definitions for the payloads found in results,
+// with one type per signal, activity or update name, and per argument for
+// names sent several
+func emitInferredTypes(language, packageName, kind, workflowType string, results []inputSample) error {
+	groups := groupValuesByName(results)
+	if len(groups) == 0 {
+		return fmt.Errorf("no JSON %s payloads found to generate types from", kind)
+	}
+
+	schemas := make([]namedSchema, 0, len(groups))
+	for _, group := range groups {
+		name := inferTypeName(kind, workflowType, group.Name)
+		if group.Args > 1 {
+			name += fmt.Sprintf(" Arg %d", group.Arg+1)
+		}
+		schemas = append(schemas, namedSchema{
+			Name:   name,
+			Schema: generateMergedJSONSchema(group.Samples, ""),
+		})
+	}
+
+	code, err := generateCode(language, packageName, schemas)
+	if err != nil {
+		return err
+	}
+	fmt.Print(code)
+	return nil
+}
+
+// inferTypeName names the type generated for the payloads of a kind sent to
+// name, such as ProcessOrderInput or ApproveSignal
+func inferTypeName(kind, workflowType, name string) string {
+	switch kind {
+	case inferKindInput:
+		return workflowType + " Input"
+	case inferKindResult:
+		return workflowType + " Result"
+	case inferKindActivity:
+		return name + " Activity Input"
+	default:
+		return name + " " + inferKindLabels[kind]
+	}
+}
+
+// lookupEmitLanguage finds a language by name or alias
+func lookupEmitLanguage(name string) (string, bool) {
+	name = strings.ToLower(name)
+	if alias, ok := emitAliases[name]; ok {
+		return alias, true
+	}
+	for _, language := range emitLanguages {
+		if language == name {
+			return language, true
+		}
+	}
+	return "", false
+}
+
+// codegenHeader is the comment each generated file starts with
+const codegenHeader = "Code generated by tempural infer-params. DO NOT EDIT."
+
+// Go
+
+// goInitialisms are words written in capitals in Go identifiers
+var goInitialisms = map[string]bool{
+	"api": true, "http": true, "https": true, "id": true, "ip": true, "json": true,
+	"sql": true, "uri": true, "url": true, "uuid": true,
+}
+
+// generateGo writes Go structs with json tags, and string types with
+// constants for enums
+func generateGo(model *codegenModel, packageName string) (string, error) {
+	var body strings.Builder
+	usesTime := false
+
+	for _, t := range model.definitions {
+		switch t.Kind {
+		case "object":
+			fmt.Fprintf(&body, "type %s struct {\n", t.Name)
+			fieldNames := make(map[string]bool)
+			for _, field := range t.Fields {
+				name := goIdentifier(field.JSONName)
+				for base, i := name, 2; fieldNames[name]; i++ {
+					name = fmt.Sprintf("%s%d", base, i)
+				}
+				fieldNames[name] = true
+
+				optional := !field.Required || field.Type.Nullable
+				tag := field.JSONName
+				if !field.Required {
+					tag += ",omitempty"
+				}
+				fieldType, time := goType(field.Type, optional)
+				usesTime = usesTime || time
+				fmt.Fprintf(&body, "\t%s %s `json:%q`\n", name, fieldType, tag)
+			}
+			body.WriteString("}\n\n")
+
+		case "string":
+			fmt.Fprintf(&body, "type %s string\n\nconst (\n", t.Name)
+			constNames := make(map[string]bool)
+			for _, value := range t.Enum {
+				suffix := goIdentifier(value)
+				if suffix == "" {
+					suffix = "Empty"
+				}
+				name := t.Name + suffix
+				for base, i := name, 2; constNames[name]; i++ {
+					name = fmt.Sprintf("%s%d", base, i)
+				}
+				constNames[name] = true
+				fmt.Fprintf(&body, "\t%s %s = %q\n", name, t.Name, value)
+			}
+			body.WriteString(")\n\n")
+
+		case "alias":
+			fieldType, time := goType(t.Items, t.Items.Nullable)
+			usesTime = usesTime || time
+			fmt.Fprintf(&body, "type %s = %s\n\n", t.Name, fieldType)
+		}
+	}
+
+	var source strings.Builder
+	fmt.Fprintf(&source, "// %s\n\npackage %s\n\n", codegenHeader, packageName)
+	if usesTime {
+		source.WriteString("import \"time\"\n\n")
+	}
+	source.WriteString(body.String())
+
+	formatted, err := format.Source([]byte(source.String()))
+	if err != nil {
+		return "", fmt.Errorf("failed to format generated Go code: %w", err)
+	}
+	return string(formatted), nil
+}
+
+// goType returns the Go type for t, and whether it needs the time package.
+// Optional values are pointers, except for slices and interfaces, which
+// already have a nil value.
+func goType(t *codegenType, optional bool) (string, bool) {
+	var name string
+	usesTime := false
+
+	switch t.Kind {
+	case "object", "alias":
+		name = t.Name
+	case "array":
+		itemType, time := goType(t.Items, t.Items.Nullable)
+		return "[]" + itemType, time
+	case "string":
+		switch {
+		case t.Name != "":
+			name = t.Name
+		case t.Format == "date-time":
+			name, usesTime = "time.Time", true
+		default:
+			name = "string"
+		}
+	case "integer":
+		name = "int64"
+	case "number":
+		name = "float64"
+	case "boolean":
+		name = "bool"
+	default:
+		return "interface{}", false
+	}
+
+	if optional {
+		name = "*" + name
+	}
+	return name, usesTime
+}
+
+// goIdentifier converts a JSON name into an exported Go identifier
+func goIdentifier(name string) string {
+	words := splitWords(name)
+	for i, word := range words {
+		if goInitialisms[strings.ToLower(word)] {
+			words[i] = strings.ToUpper(word)
+		}
+	}
+	identifier := pascalCase(words)
+	if identifier != "" && unicode.IsDigit(rune(identifier[0])) {
+		identifier = "N" + identifier
+	}
+	return identifier
+}
+
+// TypeScript
+
+// tsIdentifier matches property names that need no quotes
+var tsIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// generateTypeScript writes TypeScript interfaces, and string literal unions
+// for enums
+func generateTypeScript(model *codegenModel) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s\n", codegenHeader)
+
+	for _, t := range model.definitions {
+		b.WriteString("\n")
+		switch t.Kind {
+		case "object":
+			fmt.Fprintf(&b, "export interface %s {\n", t.Name)
+			for _, field := range t.Fields {
+				name := field.JSONName
+				if !tsIdentifier.MatchString(name) {
+					name = strconv.Quote(name)
+				}
+				if !field.Required {
+					name += "?"
+				}
+				fmt.Fprintf(&b, "  %s: %s;", name, tsType(field.Type))
+				if field.Type.Format != "" && field.Type.Name == "" {
+					fmt.Fprintf(&b, " // %s", field.Type.Format)
+				}
+				b.WriteString("\n")
+			}
+			b.WriteString("}\n")
+
+		case "string":
+			values := make([]string, 0, len(t.Enum))
+			for _, value := range t.Enum {
+				values = append(values, strconv.Quote(value))
+			}
+			fmt.Fprintf(&b, "export type %s = %s;\n", t.Name, strings.Join(values, " | "))
+
+		case "alias":
+			fmt.Fprintf(&b, "export type %s = %s;\n", t.Name, tsType(t.Items))
+		}
+	}
+	return b.String()
+}
+
+// tsType returns the TypeScript type for t
+func tsType(t *codegenType) string {
+	var name string
+	switch t.Kind {
+	case "object", "alias":
+		name = t.Name
+	case "array":
+		item := tsType(t.Items)
+		if strings.Contains(item, " | ") {
+			item = "(" + item + ")"
+		}
+		name = item + "[]"
+	case "string":
+		name = "string"
+		if t.Name != "" {
+			name = t.Name
+		}
+	case "integer", "number":
+		name = "number"
+	case "boolean":
+		name = "boolean"
+	default:
+		return "unknown"
+	}
+
+	if t.Nullable {
+		name += " | null"
+	}
+	return name
+}
+
+// Python
+
+// pythonKeywords can't be used as attribute names
+var pythonKeywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true, "async": true,
+	"await": true, "break": true, "class": true, "continue": true, "def": true, "del": true, "elif": true,
+	"else": true, "except": true, "finally": true, "for": true, "from": true, "global": true, "if": true,
+	"import": true, "in": true, "is": true, "lambda": true, "nonlocal": true, "not": true, "or": true,
+	"pass": true, "raise": true, "return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+// pythonIdentifier matches names usable as attributes
+var pythonIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// generatePython writes dataclasses, or pydantic models, and Literal aliases
+// for enums
+func generatePython(model *codegenModel, pydantic bool) string {
+	var body strings.Builder
+	imports := make(map[string]bool)
+
+	for _, t := range model.definitions {
+		switch t.Kind {
+		case "object":
+			if pydantic {
+				fmt.Fprintf(&body, "\n\nclass %s(BaseModel):\n", t.Name)
+			} else {
+				fmt.Fprintf(&body, "\n\n@dataclass\nclass %s:\n", t.Name)
+			}
+			if len(t.Fields) == 0 {
+				body.WriteString("    pass\n")
+			}
+
+			// Dataclass fields without defaults must come before those with them
+			fields := append([]codegenField(nil), t.Fields...)
+			sort.SliceStable(fields, func(i, j int) bool {
+				return fields[i].Required && !fields[j].Required
+			})
+
+			// Keys usable as attribute names keep them, and renamed keys are
+			// numbered if they would clash, as with order-id and order_id
+			fieldNames := make(map[string]bool)
+			for _, field := range fields {
+				if pythonIdentifier.MatchString(field.JSONName) && !pythonKeywords[field.JSONName] {
+					fieldNames[field.JSONName] = true
+				}
+			}
+
+			for _, field := range fields {
+				name := field.JSONName
+				renamed := !pythonIdentifier.MatchString(name) || pythonKeywords[name]
+				if renamed {
+					name = snakeCase(splitWords(name))
+					if name == "" || unicode.IsDigit(rune(name[0])) || pythonKeywords[name] {
+						name = "field_" + name
+					}
+					for base, n := name, 2; fieldNames[name]; n++ {
+						name = fmt.Sprintf("%s_%d", base, n)
+					}
+					fieldNames[name] = true
+				}
+
+				fieldType := pythonType(field.Type, imports)
+				if !field.Required && !field.Type.Nullable && field.Type.Kind != "any" {
+					fieldType = "Optional[" + fieldType + "]"
+					imports["Optional"] = true
+				}
+
+				var value string
+				switch {
+				case pydantic && renamed && !field.Required:
+					value = fmt.Sprintf(" = Field(default=None, alias=%s)", strconv.Quote(field.JSONName))
+				case pydantic && renamed:
+					value = fmt.Sprintf(" = Field(alias=%s)", strconv.Quote(field.JSONName))
+				case !field.Required:
+					value = " = None"
+				}
+				if pydantic && renamed {
+					imports["Field"] = true
+				}
+
+				fmt.Fprintf(&body, "    %s: %s%s", name, fieldType, value)
+				if renamed && !pydantic {
+					fmt.Fprintf(&body, "  # JSON key %s", strconv.Quote(field.JSONName))
+				}
+				body.WriteString("\n")
+			}
+
+		case "string":
+			values := make([]string, 0, len(t.Enum))
+			for _, value := range t.Enum {
+				values = append(values, strconv.Quote(value))
+			}
+			imports["Literal"] = true
+			fmt.Fprintf(&body, "\n\n%s = Literal[%s]\n", t.Name, strings.Join(values, ", "))
+
+		case "alias":
+			fmt.Fprintf(&body, "\n\n%s = %s\n", t.Name, pythonType(t.Items, imports))
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\nfrom __future__ import annotations\n\n", codegenHeader)
+	if !pydantic {
+		b.WriteString("from dataclasses import dataclass\n")
+	}
+	var datetimeNames []string
+	for _, name := range []string{"date", "datetime"} {
+		if imports[name] {
+			datetimeNames = append(datetimeNames, name)
+		}
+	}
+	if len(datetimeNames) > 0 {
+		fmt.Fprintf(&b, "from datetime import %s\n", strings.Join(datetimeNames, ", "))
+	}
+	var typingNames []string
+	for _, name := range []string{"Any", "List", "Literal", "Optional"} {
+		if imports[name] {
+			typingNames = append(typingNames, name)
+		}
+	}
+	if len(typingNames) > 0 {
+		fmt.Fprintf(&b, "from typing import %s\n", strings.Join(typingNames, ", "))
+	}
+	if pydantic {
+		if imports["Field"] {
+			b.WriteString("\nfrom pydantic import BaseModel, Field\n")
+		} else {
+			b.WriteString("\nfrom pydantic import BaseModel\n")
+		}
+	}
+	b.WriteString(body.String())
+	return b.String()
+}
+
+// pythonType returns the Python type hint for t, recording the names it
+// needs imported
+func pythonType(t *codegenType, imports map[string]bool) string {
+	var name string
+	switch t.Kind {
+	case "object", "alias":
+		name = t.Name
+	case "array":
+		imports["List"] = true
+		name = "List[" + pythonType(t.Items, imports) + "]"
+	case "string":
+		switch {
+		case t.Name != "":
+			name = t.Name
+		case t.Format == "date-time":
+			imports["datetime"] = true
+			name = "datetime"
+		case t.Format == "date":
+			imports["date"] = true
+			name = "date"
+		default:
+			name = "str"
+		}
+	case "integer":
+		name = "int"
+	case "number":
+		name = "float"
+	case "boolean":
+		name = "bool"
+	default:
+		imports["Any"] = true
+		return "Any"
+	}
+
+	if t.Nullable {
+		imports["Optional"] = true
+		name = "Optional[" + name + "]"
+	}
+	return name
+}
+
+// Protocol Buffers
+
+// generateProto writes proto3 messages. Field names are snake_case with a
+// json_name where needed to keep the JSON encoding the workflow expects.
+func generateProto(model *codegenModel, packageName string) string {
+	var body strings.Builder
+	imports := make(map[string]bool)
+
+	for _, t := range model.definitions {
+		switch {
+		case t.Kind == "alias":
+			// Messages can't be anything but objects, so wrap other values
+			fmt.Fprintf(&body, "\nmessage %s {\n", t.Name)
+			fmt.Fprintf(&body, "  %s value = 1;\n", protoFieldType(t.Items, imports))
+			body.WriteString("}\n")
+			continue
+		case t.Kind == "string" && t.TopLevel:
+			// Enum payloads are strings like enum fields
+			fmt.Fprintf(&body, "\nmessage %s {\n  string value = 1;%s\n}\n", t.Name, protoComment(t))
+			continue
+		case t.Kind != "object":
+			// Enum fields are written as strings, since proto enums encode
+			// differently in JSON
+			continue
+		}
+
+		fmt.Fprintf(&body, "\nmessage %s {\n", t.Name)
+		// Keys already in snake_case keep their names, and others are numbered
+		// if they would clash, as with orderId and order_id
+		fieldNames := make(map[string]bool)
+		for _, field := range t.Fields {
+			if protoFieldName(field.JSONName) == field.JSONName {
+				fieldNames[field.JSONName] = true
+			}
+		}
+
+		for i, field := range t.Fields {
+			name := protoFieldName(field.JSONName)
+			if name != field.JSONName {
+				for base, n := name, 2; fieldNames[name]; n++ {
+					name = fmt.Sprintf("%s_%d", base, n)
+				}
+				fieldNames[name] = true
+			}
+
+			fieldType := protoFieldType(field.Type, imports)
+			scalar := field.Type.Kind == "string" || field.Type.Kind == "integer" ||
+				field.Type.Kind == "number" || field.Type.Kind == "boolean"
+			if scalar && (!field.Required || field.Type.Nullable) {
+				fieldType = "optional " + fieldType
+			}
+
+			fmt.Fprintf(&body, "  %s %s = %d", fieldType, name, i+1)
+			if protoJSONName(name) != field.JSONName {
+				fmt.Fprintf(&body, " [json_name = %s]", strconv.Quote(field.JSONName))
+			}
+			body.WriteString(";" + protoComment(field.Type) + "\n")
+		}
+		body.WriteString("}\n")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s\n\nsyntax = \"proto3\";\n\npackage %s;\n", codegenHeader, packageName)
+	if imports["struct"] || imports["timestamp"] {
+		b.WriteString("\n")
+	}
+	if imports["struct"] {
+		b.WriteString("import \"google/protobuf/struct.proto\";\n")
+	}
+	if imports["timestamp"] {
+		b.WriteString("import \"google/protobuf/timestamp.proto\";\n")
+	}
+	b.WriteString(body.String())
+	return b.String()
+}
+
+// protoComment notes the values or format a string type allows, which the
+// proto type itself can't express
+func protoComment(t *codegenType) string {
+	if len(t.Enum) > 0 {
+		quoted := make([]string, 0, len(t.Enum))
+		for _, value := range t.Enum {
+			quoted = append(quoted, strconv.Quote(value))
+		}
+		return " // one of " + strings.Join(quoted, ", ")
+	}
+	if t.Format != "" && t.Format != "date-time" {
+		return " // " + t.Format
+	}
+	return ""
+}
+
+// protoFieldType returns the proto type of a field, recording the
+// well-known types it needs imported
+func protoFieldType(t *codegenType, imports map[string]bool) string {
+	switch t.Kind {
+	case "object", "alias":
+		return t.Name
+	case "array":
+		// Repeated fields can't nest, so arrays of arrays become lists of values
+		if t.Items.Kind == "array" {
+			imports["struct"] = true
+			return "repeated google.protobuf.ListValue"
+		}
+		return "repeated " + protoFieldType(t.Items, imports)
+	case "string":
+		if t.Format == "date-time" && t.Name == "" {
+			imports["timestamp"] = true
+			return "google.protobuf.Timestamp"
+		}
+		return "string"
+	case "integer":
+		// Proto JSON writes 64-bit integers as strings, which a workflow
+		// expecting numbers can't decode
+		return "int32"
+	case "number":
+		return "double"
+	case "boolean":
+		return "bool"
+	default:
+		imports["struct"] = true
+		return "google.protobuf.Value"
+	}
+}
+
+// protoFieldName converts a JSON key into a snake_case field name
+func protoFieldName(key string) string {
+	name := snakeCase(splitWords(key))
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "field_" + name
+	}
+	return name
+}
+
+// protoJSONName is the JSON name protoc gives a field by default
+func protoJSONName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Identifiers
+
+// splitWords splits a name into words at separators and case changes, so
+// that "orderID", "order_id" and "order-id" all give "order" and "ID"
+func splitWords(name string) []string {
+	var words []string
+	runes := []rune(name)
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+			continue
+		}
+
+		prev := runes[i-1]
+		lowerToUpper := unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev))
+		// The last capital of a run starts a new word, as in "HTTPServer"
+		acronymEnd := unicode.IsUpper(r) && unicode.IsUpper(prev) &&
+			i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if lowerToUpper || acronymEnd {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// pascalCase joins words capitalizing each one. Words already in capitals,
+// like initialisms, are kept as they are.
+func pascalCase(words []string) string {
+	var b strings.Builder
+	for _, word := range words {
+		if word == strings.ToUpper(word) && len(word) > 1 {
+			b.WriteString(word)
+			continue
+		}
+		runes := []rune(strings.ToLower(word))
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+	return b.String()
+}
+
+// snakeCase joins words in lower case with underscores
+func snakeCase(words []string) string {
+	lower := make([]string, 0, len(words))
+	for _, word := range words {
+		lower = append(lower, strings.ToLower(word))
+	}
+	return strings.Join(lower, "_")
+}
+
+// singular names the element type of an array type
+func singular(name string) string {
+	if strings.HasSuffix(name, "ies") && len(name) > 4 {
+		return strings.TrimSuffix(name, "ies") + "y"
+	}
+	if strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss") && len(name) > 3 {
+		return strings.TrimSuffix(name, "s")
+	}
+	return name + "Item"
+}
@@ -0,0 +1,208 @@
+package app
+
+import (
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// orderSchemas are the inferred schemas of a workflow input and a signal
+func orderSchemas(t *testing.T) []namedSchema {
+	t.Helper()
+
+	input := decodeSamples(t,
+		`{"order-id": "a1", "placedAt": "2024-05-01T12:00:00Z", "priority": "high", "items": [{"sku": "x", "quantity": 1}], "notes": null}`,
+		`{"order-id": "b2", "placedAt": "2024-05-02T08:30:00Z", "priority": "low", "items": [{"sku": "y", "quantity": 2, "gift": true}], "notes": "fragile"}`,
+		`{"order-id": "c3", "placedAt": "2024-05-03T00:00:00Z", "priority": "high", "items": []}`,
+		`{"order-id": "d4", "placedAt": "2024-05-04T10:00:00Z", "priority": "low", "items": []}`,
+	)
+	return []namedSchema{
+		{Name: inferTypeName(inferKindInput, "process-order", ""), Schema: generateMergedJSONSchema(input, "")},
+		{Name: inferTypeName(inferKindSignal, "", "approve"), Schema: generateMergedJSONSchema(decodeSamples(t, `"yes"`), "")},
+	}
+}
+
+func TestGenerateGo(t *testing.T) {
+	code, err := generateCode(emitGo, "orders", orderSchemas(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "types.go", code, 0); err != nil {
+		t.Fatalf("generated code doesn't parse: %v\n%s", err, code)
+	}
+
+	for _, want := range []string{
+		"package orders",
+		"type ProcessOrderInput struct",
+		"OrderID  string",
+		"`json:\"order-id\"`",
+		"PlacedAt time.Time",
+		"Items    []ProcessOrderInputItem",
+		"Notes    *string",
+		"`json:\"notes,omitempty\"`",
+		"Gift     *bool",
+		"ProcessOrderInputPriorityHigh ProcessOrderInputPriority = \"high\"",
+		"type ApproveSignal = string",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated Go is missing %q:\n%s", want, code)
+		}
+	}
+}
+
+func TestGenerateTypeScript(t *testing.T) {
+	code, err := generateCode(emitTypeScript, "", orderSchemas(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"export interface ProcessOrderInput {",
+		`  "order-id": string;`,
+		"  notes?: string | null;",
+		"  gift?: boolean;",
+		`export type ProcessOrderInputPriority = "high" | "low";`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated TypeScript is missing %q:\n%s", want, code)
+		}
+	}
+}
+
+func TestGeneratePython(t *testing.T) {
+	code, err := generateCode(emitPydantic, "", orderSchemas(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Fields with defaults follow the required ones
+	item := code[strings.Index(code, "class ProcessOrderInputItem"):]
+	if strings.Index(item, "gift:") < strings.Index(item, "sku:") {
+		t.Errorf("optional field comes before a required one:\n%s", code)
+	}
+	for _, want := range []string{
+		`    order_id: str = Field(alias="order-id")`,
+		"    placedAt: datetime",
+		"    notes: Optional[str] = None",
+		"from pydantic import BaseModel, Field",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated Python is missing %q:\n%s", want, code)
+		}
+	}
+}
+
+func TestGenerateProto(t *testing.T) {
+	code, err := generateCode(emitProto, "orders.v1", orderSchemas(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"package orders.v1;",
+		`import "google/protobuf/timestamp.proto";`,
+		`  string order_id = 3 [json_name = "order-id"];`,
+		"  google.protobuf.Timestamp placed_at = 4;",
+		"  repeated ProcessOrderInputItem items = 1;",
+		"  optional string notes = 2;",
+		"  int32 quantity = 2;",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated proto is missing %q:\n%s", want, code)
+		}
+	}
+}
+
+func TestSplitWords(t *testing.T) {
+	for name, want := range map[string][]string{
+		"orderId":        {"order", "Id"},
+		"order_id":       {"order", "id"},
+		"source-system":  {"source", "system"},
+		"HTTPServerURL":  {"HTTP", "Server", "URL"},
+		"ProcessOrderV2": {"Process", "Order", "V2"},
+	} {
+		if got := splitWords(name); !reflect.DeepEqual(got, want) {
+			t.Errorf("splitWords(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestGenerateProtoEnumPayload(t *testing.T) {
+	schemas := []namedSchema{{
+		Name:   inferTypeName(inferKindSignal, "", "set-priority"),
+		Schema: generateMergedJSONSchema(decodeSamples(t, `"high"`, `"low"`, `"high"`, `"low"`), ""),
+	}}
+	code, err := generateCode(emitProto, "orders", schemas)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"message SetPrioritySignal {",
+		`  string value = 1; // one of "high", "low"`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated proto is missing %q:\n%s", want, code)
+		}
+	}
+}
+
+func TestGenerateTypeNameStartingWithDigit(t *testing.T) {
+	schemas := []namedSchema{{
+		Name:   inferTypeName(inferKindActivity, "", "3DSecure"),
+		Schema: generateMergedJSONSchema(decodeSamples(t, `{"card": {"last4": "4242"}}`), ""),
+	}}
+
+	code, err := generateCode(emitGo, "payments", schemas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "types.go", code, 0); err != nil {
+		t.Fatalf("generated code doesn't parse: %v\n%s", err, code)
+	}
+	for _, want := range []string{
+		"type N3DSecureActivityInput struct",
+		"Card N3DSecureActivityInputCard",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated Go is missing %q:\n%s", want, code)
+		}
+	}
+}
+
+func TestGenerateDuplicateFieldNames(t *testing.T) {
+	schemas := []namedSchema{{
+		Name:   "Order",
+		Schema: generateMergedJSONSchema(decodeSamples(t, `{"orderId": "a", "order_id": "b", "order-id": "c"}`), ""),
+	}}
+
+	code, err := generateCode(emitProto, "orders", schemas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`  string order_id_2 = 1 [json_name = "order-id"];`,
+		`  string order_id_3 = 2 [json_name = "orderId"];`,
+		`  string order_id = 3 [json_name = "order_id"];`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated proto is missing %q:\n%s", want, code)
+		}
+	}
+
+	code, err = generateCode(emitPydantic, "", schemas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`    order_id_2: str = Field(alias="order-id")`,
+		"    orderId: str\n",
+		"    order_id: str\n",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated Python is missing %q:\n%s", want, code)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+// groupByClause matches a trailing GROUP BY clause in a visibility query
+var groupByClause = regexp.MustCompile(`(?is)^(.*?)\s*\bGROUP\s+BY\s+(.+?)\s*$`)
+
+// countGroup is the number of workflows sharing the values of the grouped fields
+type countGroup struct {
+	Values []interface{} `json:"values" yaml:"values"`
+	Count  int64         `json:"count" yaml:"count"`
+}
+
+// countResult is the structured output of the count command
+type countResult struct {
+	Query   string       `json:"query" yaml:"query"`
+	GroupBy string       `json:"groupBy,omitempty" yaml:"groupBy,omitempty"`
+	Count   int64        `json:"count" yaml:"count"`
+	Groups  []countGroup `json:"groups,omitempty" yaml:"groups,omitempty"`
+}
+
+// splitGroupBy separates a trailing GROUP BY clause from a query, since the
+// filters are combined before grouping is applied
+func splitGroupBy(query string) (filter, groupBy string) {
+	if match := groupByClause.FindStringSubmatch(query); match != nil {
+		return match[1], match[2]
+	}
+	return query, ""
+}
+
+// countWorkflows counts the workflows matching the visibility filters, per
+// group when grouping is requested
+func countWorkflows(c *cli.Context, config TemporalConfig) error {
+	filter, groupBy := splitGroupBy(c.String("query"))
+	if c.IsSet("group-by") {
+		if groupBy != "" {
+			return fmt.Errorf("use either --group-by or GROUP BY in --query, not both")
+		}
+		groupBy = c.String("group-by")
+	}
+
+	query, err := buildListQuery(c, filter, nil)
+	if err != nil {
+		return err
+	}
+	fullQuery := query
+	if groupBy != "" {
+		fullQuery = strings.TrimSpace(query + " GROUP BY " + groupBy)
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Counting can scan a large part of the visibility store
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := temporalClient.CountWorkflow(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: config.Namespace,
+		Query:     fullQuery,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to count workflows: %w", err)
+	}
+
+	result := countResult{
+		Query:   query,
+		GroupBy: groupBy,
+		Count:   resp.GetCount(),
+	}
+	for _, group := range resp.GetGroups() {
+		values := make([]interface{}, 0, len(group.GetGroupValues()))
+		for _, payload := range group.GetGroupValues() {
+			values = append(values, decodePayload(payload))
+		}
+		result.Groups = append(result.Groups, countGroup{
+			Values: values,
+			Count:  group.GetCount(),
+		})
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, result)
+	}
+
+	printCountResult(result)
+	return nil
+}
+
+// printCountResult prints the total and, when grouped, a table of counts per group
+func printCountResult(result countResult) {
+	if result.Query == "" {
+		fmt.Printf("%s%d%s workflows\n", colorBold, result.Count, colorReset)
+	} else {
+		fmt.Printf("%s%d%s workflows match %s\n", colorBold, result.Count, colorReset, result.Query)
+	}
+	if len(result.Groups) == 0 {
+		return
+	}
+
+	labels := make([]string, 0, len(result.Groups))
+	width := len(result.GroupBy)
+	for _, group := range result.Groups {
+		parts := make([]string, 0, len(group.Values))
+		for _, value := range group.Values {
+			parts = append(parts, fmt.Sprint(value))
+		}
+		label := strings.Join(parts, ", ")
+		labels = append(labels, label)
+		if len(label) > width {
+			width = len(label)
+		}
+	}
+
+	fmt.Println()
+	fmt.Printf("%s%-*s  %10s%s\n", colorBold, width, result.GroupBy, "Count", colorReset)
+	for i, group := range result.Groups {
+		fmt.Printf("%-*s  %10d\n", width, labels[i], group.Count)
+	}
+}
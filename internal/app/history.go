@@ -0,0 +1,457 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/history/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// historyEvent is the structured form of a workflow history event
+type historyEvent struct {
+	EventID    int64                  `json:"eventId" yaml:"eventId"`
+	EventTime  *time.Time             `json:"eventTime,omitempty" yaml:"eventTime,omitempty"`
+	EventType  string                 `json:"eventType" yaml:"eventType"`
+	Attributes map[string]interface{} `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+}
+
+// summaryKeys are the attributes shown in the compact history view, in display order
+var summaryKeys = []string{
+	"workflowType",
+	"activityType",
+	"activityId",
+	"timerId",
+	"startToFireTimeout",
+	"signalName",
+	"markerName",
+	"namespace",
+	"workflowExecution",
+	"input",
+	"result",
+	"details",
+	"reason",
+	"cause",
+	"timeoutType",
+	"retryState",
+	"failure",
+}
+
+// maxSummaryValueLength caps how much of a single value the compact view prints
+const maxSummaryValueLength = 60
+
+var (
+	payloadType   = reflect.TypeOf(&common.Payload{})
+	payloadsType  = reflect.TypeOf(&common.Payloads{})
+	timeType      = reflect.TypeOf(time.Time{})
+	durationType  = reflect.TypeOf(time.Duration(0))
+	stringerType  = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	byteSliceType = reflect.TypeOf([]byte(nil))
+)
+
+// showHistory prints every event in a workflow's history
+func showHistory(c *cli.Context, config TemporalConfig) error {
+	if config.WorkflowID == "" {
+		return fmt.Errorf("workflow ID is required")
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	runID := c.String("run-id")
+	if runID == "" {
+		runID = config.RunID
+	}
+
+	if c.Bool("follow") {
+		return followHistory(c, config, temporalClient, runID)
+	}
+
+	// Histories can span many pages, so allow more time than a single call
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var events []historyEvent
+	iter := temporalClient.GetWorkflowHistory(ctx, config.WorkflowID, runID, false, 0)
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return fmt.Errorf("failed to get workflow history: %w", err)
+		}
+		events = append(events, newHistoryEvent(event))
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, events)
+	}
+
+	fmt.Printf("%s%s==== History: %s ====%s\n", colorBold, colorBlue, config.WorkflowID, colorReset)
+	for _, event := range events {
+		if c.Bool("detail") {
+			printEventDetail(event)
+		} else {
+			printEventLine(event)
+		}
+	}
+	fmt.Printf("\n%d events\n", len(events))
+
+	return nil
+}
+
+// followHistory streams events as they are added to a workflow's history,
+// following continue-as-new, until the workflow closes. The returned error
+// reflects how the workflow closed.
+func followHistory(c *cli.Context, config TemporalConfig, temporalClient client.Client, runID string) error {
+	// Long polls have no overall deadline; Ctrl-C stops following
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// JSON and YAML documents can only be printed once the workflow has closed
+	stream := !isStructuredOutput(config) || config.Output == outputJSONL
+	var events []historyEvent
+
+	if !isStructuredOutput(config) {
+		fmt.Printf("%s%s==== Following history: %s ====%s\n", colorBold, colorBlue, config.WorkflowID, colorReset)
+	}
+
+	var status string
+	for status == "" {
+		iter := temporalClient.GetWorkflowHistory(ctx, config.WorkflowID, runID, true,
+			enums.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+		nextRunID := ""
+
+		for iter.HasNext() {
+			event, err := iter.Next()
+			if err != nil {
+				if ctx.Err() != nil {
+					infof(config, "\nStopped following %s\n", config.WorkflowID)
+					return nil
+				}
+				return fmt.Errorf("failed to get workflow history: %w", err)
+			}
+
+			rendered := newHistoryEvent(event)
+			switch {
+			case !stream:
+				events = append(events, rendered)
+			case isStructuredOutput(config):
+				if err := printStructured(config, rendered); err != nil {
+					return err
+				}
+			case c.Bool("detail"):
+				printEventDetail(rendered)
+			default:
+				printEventLine(rendered)
+			}
+
+			switch event.GetEventType() {
+			case enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+				status = "Completed"
+			case enums.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+				status = "Failed"
+			case enums.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+				status = "TimedOut"
+			case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:
+				status = "Canceled"
+			case enums.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:
+				status = "Terminated"
+			case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+				nextRunID = event.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunId()
+			}
+		}
+
+		if status != "" {
+			break
+		}
+		if nextRunID == "" {
+			return fmt.Errorf("history of %s ended before the workflow closed", config.WorkflowID)
+		}
+
+		infof(config, "%s---- Continued as new run %s ----%s\n", colorCyan, nextRunID, colorReset)
+		runID = nextRunID
+	}
+
+	if !stream {
+		if err := printStructured(config, events); err != nil {
+			return err
+		}
+	}
+
+	if !isStructuredOutput(config) {
+		color := colorRed
+		if status == "Completed" {
+			color = colorGreen
+		}
+		fmt.Printf("\n%sWorkflow %s%s\n", color, strings.ToLower(status), colorReset)
+	}
+
+	return workflowClosedError(status)
+}
+
+// workflowExitCodes are the exit codes of history --follow for workflows that
+// closed without completing, so scripts can tell the outcomes apart
+var workflowExitCodes = map[string]int{
+	"Failed":     2,
+	"TimedOut":   3,
+	"Canceled":   4,
+	"Terminated": 5,
+}
+
+// workflowClosedError returns the error for a workflow that closed with the
+// given status, or nil if it completed
+func workflowClosedError(status string) error {
+	if status == "Completed" {
+		return nil
+	}
+	code, ok := workflowExitCodes[status]
+	if !ok {
+		code = 1
+	}
+	return cli.Exit(fmt.Sprintf("workflow %s", strings.ToLower(status)), code)
+}
+
+// newHistoryEvent converts a history event to its structured form, decoding payloads
+func newHistoryEvent(event *history.HistoryEvent) historyEvent {
+	result := historyEvent{
+		EventID:   event.GetEventId(),
+		EventTime: event.GetEventTime(),
+		EventType: event.GetEventType().String(),
+	}
+
+	// The attributes are a oneof wrapper holding a single *XxxEventAttributes field
+	value := reflect.ValueOf(event.GetAttributes())
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return result
+		}
+		value = value.Elem()
+	}
+	if value.Kind() == reflect.Struct && value.NumField() == 1 {
+		value = value.Field(0)
+	}
+
+	if attributes, ok := protoValue(value).(map[string]interface{}); ok {
+		result.Attributes = attributes
+	}
+	return result
+}
+
+// protoValue converts an API message field into plain values that print well as
+// JSON: payloads are decoded, enums and durations become strings, and unset
+// fields are dropped. It returns nil for unset values.
+func protoValue(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+
+	switch value.Type() {
+	case payloadsType:
+		if value.IsNil() {
+			return nil
+		}
+		return decodePayloads(value.Interface().(*common.Payloads))
+	case payloadType:
+		if value.IsNil() {
+			return nil
+		}
+		return decodePayload(value.Interface().(*common.Payload))
+	case timeType:
+		t := value.Interface().(time.Time)
+		if t.IsZero() {
+			return nil
+		}
+		return t
+	case durationType:
+		if value.Int() == 0 {
+			return nil
+		}
+		return value.Interface().(time.Duration).String()
+	case byteSliceType:
+		if value.Len() == 0 {
+			return nil
+		}
+		return string(value.Bytes())
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return protoValue(value.Elem())
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() || strings.HasPrefix(field.Name, "XXX_") {
+				continue
+			}
+			if converted := protoValue(value.Field(i)); converted != nil {
+				fields[lowerFirst(field.Name)] = converted
+			}
+		}
+		if len(fields) == 0 {
+			return nil
+		}
+		return fields
+	case reflect.Slice, reflect.Array:
+		if value.Len() == 0 {
+			return nil
+		}
+		items := make([]interface{}, value.Len())
+		for i := range items {
+			items[i] = protoValue(value.Index(i))
+		}
+		return items
+	case reflect.Map:
+		if value.Len() == 0 {
+			return nil
+		}
+		entries := make(map[string]interface{}, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			entries[fmt.Sprint(iter.Key().Interface())] = protoValue(iter.Value())
+		}
+		return entries
+	}
+
+	if value.IsZero() {
+		return nil
+	}
+	// Enums print as their names rather than their numbers
+	if value.Type().Implements(stringerType) && value.Kind() != reflect.String {
+		return value.Interface().(fmt.Stringer).String()
+	}
+	if value.CanInterface() {
+		return value.Convert(builtinTypeOf(value)).Interface()
+	}
+	return nil
+}
+
+// builtinTypeOf returns the builtin type for a value's kind, so named
+// scalar types print as plain numbers, strings and bools
+func builtinTypeOf(value reflect.Value) reflect.Type {
+	switch value.Kind() {
+	case reflect.String:
+		return reflect.TypeOf("")
+	case reflect.Bool:
+		return reflect.TypeOf(false)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.TypeOf(int64(0))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.TypeOf(uint64(0))
+	case reflect.Float32, reflect.Float64:
+		return reflect.TypeOf(float64(0))
+	}
+	return value.Type()
+}
+
+// lowerFirst lower-cases the first letter of a Go field name to match the API's JSON names
+func lowerFirst(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToLower(r)) + name[size:]
+}
+
+// eventSummary renders the most useful attributes of an event on a single line
+func eventSummary(event historyEvent) string {
+	var parts []string
+	for _, key := range summaryKeys {
+		value, ok := event.Attributes[key]
+		if !ok {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", key, summaryValue(value)))
+	}
+	return strings.Join(parts, " ")
+}
+
+// summaryValue shortens an attribute value for the compact history view
+func summaryValue(value interface{}) string {
+	if fields, ok := value.(map[string]interface{}); ok {
+		// Types and failures are best identified by their name or message
+		if name, ok := fields["name"]; ok && len(fields) == 1 {
+			return fmt.Sprint(name)
+		}
+		if message, ok := fields["message"]; ok {
+			value = message
+		}
+	}
+
+	var text string
+	if s, ok := value.(string); ok {
+		text = s
+	} else if data, err := json.Marshal(value); err == nil {
+		text = string(data)
+	} else {
+		text = fmt.Sprint(value)
+	}
+
+	if utf8.RuneCountInString(text) > maxSummaryValueLength {
+		text = string([]rune(text)[:maxSummaryValueLength-3]) + "..."
+	}
+	if strings.ContainsAny(text, " \t\n") {
+		text = fmt.Sprintf("%q", text)
+	}
+	return text
+}
+
+// formatEventTime renders an event timestamp for the human-readable views
+func formatEventTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04:05.000")
+}
+
+// eventColor picks a color that makes failures and completions stand out
+func eventColor(eventType string) string {
+	switch {
+	case strings.HasSuffix(eventType, "Failed"), strings.HasSuffix(eventType, "TimedOut"),
+		strings.HasSuffix(eventType, "Terminated"):
+		return colorRed
+	case strings.HasSuffix(eventType, "Canceled"), strings.HasSuffix(eventType, "CancelRequested"):
+		return colorYellow
+	case strings.HasSuffix(eventType, "Completed"):
+		return colorGreen
+	}
+	return ""
+}
+
+// printEventLine prints an event in the compact one-line-per-event view
+func printEventLine(event historyEvent) {
+	fmt.Printf("%5d  %s  %s%-40s%s %s\n",
+		event.EventID,
+		formatEventTime(event.EventTime),
+		eventColor(event.EventType), event.EventType, colorReset,
+		eventSummary(event),
+	)
+}
+
+// printEventDetail prints an event with all of its decoded attributes
+func printEventDetail(event historyEvent) {
+	fmt.Printf("\n%s%d  %s%s%s  %s\n",
+		colorBold, event.EventID,
+		eventColor(event.EventType), event.EventType, colorReset,
+		formatEventTime(event.EventTime),
+	)
+	if len(event.Attributes) == 0 {
+		return
+	}
+	for _, line := range strings.Split(formatJSON(event.Attributes), "\n") {
+		fmt.Printf("  %s\n", line)
+	}
+}
@@ -0,0 +1,400 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflow/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// inferMaxCandidates caps how many matching executions are listed to draw the
+// sample from, so that inference over busy workflow types stays quick
+const inferMaxCandidates = 5000
+
+// Kinds of payload infer-params can infer schemas for
+const (
+	inferKindInput    = "input"
+	inferKindSignal   = "signal"
+	inferKindActivity = "activity"
+	inferKindResult   = "result"
+	inferKindUpdate   = "update"
+)
+
+// inferKinds lists the accepted --kind values
+var inferKinds = []string{inferKindInput, inferKindSignal, inferKindActivity, inferKindResult, inferKindUpdate}
+
+// inferKindLabels name each kind in headings
+var inferKindLabels = map[string]string{
+	inferKindInput:    "Input",
+	inferKindSignal:   "Signal",
+	inferKindActivity: "Activity",
+	inferKindResult:   "Result",
+	inferKindUpdate:   "Update",
+}
+
+// namedValue is a decoded payload along with the signal, activity or update
+// it was sent to. Workflow inputs and results are named after the workflow type.
+type namedValue struct {
+	Name string
+	// Arg is the position of the payload among the arguments it was sent with
+	Arg   int
+	Value interface{}
+}
+
+// inputSample holds the payloads of one kind found in one workflow run
+type inputSample struct {
+	WorkflowID string
+	RunID      string
+	// Values are the payloads that decoded as JSON, and Invalid the raw data
+	// of those that didn't
+	Values  []namedValue
+	Invalid []string
+	// Payloads counts every payload, including empty ones
+	Payloads int
+	Err      error
+}
+
+// add decodes the payloads sent to name
+func (s *inputSample) add(name string, payloads *common.Payloads) {
+	for arg, payload := range payloads.GetPayloads() {
+		s.Payloads++
+		data := payload.GetData()
+		if len(data) == 0 {
+			continue
+		}
+
+		var value interface{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			s.Invalid = append(s.Invalid, string(data))
+			continue
+		}
+		s.Values = append(s.Values, namedValue{Name: name, Arg: arg, Value: value})
+	}
+}
+
+// buildInferQuery selects the executions of a workflow type, narrowed by the
+// --query and --since flags
+func buildInferQuery(c *cli.Context, workflowType string) (string, error) {
+	clauses := []string{"WorkflowType=" + quoteQueryValue(workflowType)}
+
+	if query := strings.TrimSpace(c.String("query")); query != "" {
+		clauses = append(clauses, "("+query+")")
+	}
+
+	if since := c.String("since"); since != "" {
+		t, err := parseTimeFlag(since)
+		if err != nil {
+			return "", fmt.Errorf("invalid --since: %w", err)
+		}
+		clauses = append(clauses, fmt.Sprintf("StartTime >= '%s'", t.UTC().Format(time.RFC3339)))
+	}
+
+	return strings.Join(clauses, " AND "), nil
+}
+
+// spreadSample picks limit executions spaced evenly through candidates, which
+// are ordered by start time, so the sample covers the whole period rather
+// than only the most recent runs
+func spreadSample(candidates []*workflow.WorkflowExecutionInfo, limit int) []*workflow.WorkflowExecutionInfo {
+	if limit <= 0 || len(candidates) <= limit {
+		return candidates
+	}
+	if limit == 1 {
+		return candidates[:1]
+	}
+
+	sample := make([]*workflow.WorkflowExecutionInfo, 0, limit)
+	for i := 0; i < limit; i++ {
+		sample = append(sample, candidates[i*(len(candidates)-1)/(limit-1)])
+	}
+	return sample
+}
+
+// fetchInputSamples reads the payloads of a kind from the histories of
+// executions, using a pool of concurrency workers. Results are in the same
+// order as executions.
+func fetchInputSamples(ctx context.Context, temporalClient client.Client, executions []*workflow.WorkflowExecutionInfo,
+	kind string, concurrency int) []inputSample {
+	results := make([]inputSample, len(executions))
+	jobs := make(chan int)
+
+	// Walking a full history takes longer than reading its first event
+	timeout := 10 * time.Second
+	if kind != inferKindInput {
+		timeout = 30 * time.Second
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(executions); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				execution := executions[index]
+				fetchCtx, cancel := context.WithTimeout(ctx, timeout)
+				results[index] = readInputSample(fetchCtx, temporalClient, execution.Execution.WorkflowId,
+					execution.Execution.RunId, execution.Type.GetName(), kind)
+				cancel()
+			}
+		}()
+	}
+
+	next := 0
+dispatch:
+	for ; next < len(executions); next++ {
+		// select picks at random among ready cases, so check for cancellation
+		// first to stop handing out fetches as soon as it happens
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case jobs <- next:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+
+	// Runs that were never handed out are canceled
+	for index := next; index < len(executions); index++ {
+		results[index] = inputSample{
+			WorkflowID: executions[index].Execution.WorkflowId,
+			RunID:      executions[index].Execution.RunId,
+			Err:        ctx.Err(),
+		}
+	}
+	wg.Wait()
+
+	return results
+}
+
+// readInputSample reads the payloads of a kind from a workflow run's history
+func readInputSample(ctx context.Context, temporalClient client.Client, workflowID, runID, workflowType, kind string) inputSample {
+	sample := inputSample{WorkflowID: workflowID, RunID: runID}
+
+	// Results are in the close event, which the server can return on its own
+	filter := enums.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT
+	if kind == inferKindResult {
+		filter = enums.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT
+	}
+
+	iter := temporalClient.GetWorkflowHistory(ctx, workflowID, runID, false, filter)
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			sample.Err = err
+			return sample
+		}
+
+		switch kind {
+		case inferKindInput:
+			// The started event is always the first one
+			sample.add(workflowType, event.GetWorkflowExecutionStartedEventAttributes().GetInput())
+			return sample
+		case inferKindSignal:
+			if attrs := event.GetWorkflowExecutionSignaledEventAttributes(); attrs != nil {
+				sample.add(attrs.GetSignalName(), attrs.GetInput())
+			}
+		case inferKindActivity:
+			if attrs := event.GetActivityTaskScheduledEventAttributes(); attrs != nil {
+				sample.add(attrs.GetActivityType().GetName(), attrs.GetInput())
+			}
+		case inferKindResult:
+			if attrs := event.GetWorkflowExecutionCompletedEventAttributes(); attrs != nil {
+				sample.add(workflowType, attrs.GetResult())
+			}
+		case inferKindUpdate:
+			if attrs := event.GetWorkflowExecutionUpdateAcceptedEventAttributes(); attrs != nil {
+				input := attrs.GetAcceptedRequest().GetInput()
+				sample.add(input.GetName(), input.GetArgs())
+			}
+		}
+	}
+	return sample
+}
+
+// payloadGroup holds the payloads sent at one argument position of a name.
+// Arguments in different positions have unrelated shapes, so each position
+// gets a schema of its own.
+type payloadGroup struct {
+	Name string
+	// Arg is the argument position, and Args how many positions were seen
+	// for the name
+	Arg  int
+	Args int
+	// Samples are every payload, and Examples one of each distinct structure
+	Samples  []interface{}
+	Examples []interface{}
+}
+
+// label names the group in headings, adding the argument position only for
+// names sent several arguments
+func (g payloadGroup) label() string {
+	if g.Args > 1 {
+		return fmt.Sprintf("%s (argument %d)", g.Name, g.Arg+1)
+	}
+	return g.Name
+}
+
+// groupValuesByName collects the payloads of results per name and argument
+// position, sorted by name and then position
+func groupValuesByName(results []inputSample) []payloadGroup {
+	type groupKey struct {
+		name string
+		arg  int
+	}
+	groups := make(map[groupKey]*payloadGroup)
+	args := make(map[string]int)
+	seen := make(map[string]bool)
+	for _, result := range results {
+		for _, value := range result.Values {
+			key := groupKey{value.Name, value.Arg}
+			group, ok := groups[key]
+			if !ok {
+				group = &payloadGroup{Name: value.Name, Arg: value.Arg}
+				groups[key] = group
+			}
+			group.Samples = append(group.Samples, value.Value)
+			if value.Arg+1 > args[value.Name] {
+				args[value.Name] = value.Arg + 1
+			}
+
+			// Keep one example of each distinct structure
+			structure := fmt.Sprintf("%s\x00%d\x00%v", value.Name, value.Arg, getStructureJSON(value.Value))
+			if !seen[structure] {
+				seen[structure] = true
+				group.Examples = append(group.Examples, value.Value)
+			}
+		}
+	}
+
+	sorted := make([]payloadGroup, 0, len(groups))
+	for _, group := range groups {
+		group.Args = args[group.Name]
+		sorted = append(sorted, *group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Arg < sorted[j].Arg
+	})
+	return sorted
+}
+
+// argumentsSchema describes the arguments sent to a name: the schema of its
+// only argument, or a tuple of one schema per position when it has several
+func argumentsSchema(groups []payloadGroup) map[string]interface{} {
+	if len(groups) == 1 && groups[0].Args == 1 {
+		return generateMergedJSONSchema(groups[0].Samples, "")
+	}
+
+	// Positions where no JSON payload was seen allow any value
+	items := make([]interface{}, groups[0].Args)
+	for i := range items {
+		items[i] = map[string]interface{}{}
+	}
+	for _, group := range groups {
+		schema := generateMergedJSONSchema(group.Samples, "")
+		delete(schema, "$schema")
+		items[group.Arg] = schema
+	}
+	return map[string]interface{}{
+		"$schema": jsonSchemaDraft,
+		"type":    "array",
+		"items":   items,
+	}
+}
+
+// inferByName merges the payloads of each signal, activity, update or result
+// into a schema of their own and prints them
+func inferByName(config TemporalConfig, kind, workflowType string, results []inputSample, asJSONSchema, rawOutput bool) error {
+	groups := groupValuesByName(results)
+
+	// Positions of the same name are adjacent, since groups are sorted
+	var names []string
+	byName := make(map[string][]payloadGroup)
+	for _, group := range groups {
+		if _, ok := byName[group.Name]; !ok {
+			names = append(names, group.Name)
+		}
+		byName[group.Name] = append(byName[group.Name], group)
+	}
+
+	label := inferKindLabels[kind]
+	if asJSONSchema {
+		schemas := make(map[string]interface{}, len(names))
+		for _, name := range names {
+			schema := argumentsSchema(byName[name])
+			schema["title"] = fmt.Sprintf("%s %s", name, label)
+			schema["description"] = fmt.Sprintf("Schema of %s %s payloads in %s workflows", name, kind, workflowType)
+			schemas[name] = schema
+		}
+
+		if isStructuredOutput(config) {
+			return printStructured(config, schemas)
+		}
+
+		var output []byte
+		var err error
+		if rawOutput {
+			output, err = json.Marshal(schemas)
+		} else {
+			output, err = json.MarshalIndent(schemas, "", "  ")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to generate JSONSchema: %w", err)
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	if isStructuredOutput(config) {
+		inferred := make([]inferResult, 0, len(groups))
+		for _, group := range groups {
+			result := inferResult{
+				WorkflowType: workflowType,
+				Kind:         kind,
+				Name:         group.Name,
+				Structures:   group.Examples,
+			}
+			if group.Args > 1 {
+				result.Arg = group.Arg + 1
+			}
+			inferred = append(inferred, result)
+		}
+		return printStructured(config, inferred)
+	}
+
+	if rawOutput {
+		return nil
+	}
+
+	if len(names) == 0 {
+		fmt.Printf("\n%s%s==== No %s Payloads Found ====%s\n", colorBold, colorRed, label, colorReset)
+		fmt.Printf("None of the examined workflows had %s payloads in their history.\n", kind)
+		return nil
+	}
+
+	fmt.Printf("\n%s%s==== Inferred %s Payloads ====%s\n", colorBold, colorGreen, label, colorReset)
+	for _, group := range groups {
+		fmt.Printf("\n%s%s %s%s (%d payloads, %d distinct structures)\n",
+			colorBold, label, group.label(), colorReset, len(group.Samples), len(group.Examples))
+		for _, example := range group.Examples {
+			fmt.Println(formatJSON(example))
+		}
+	}
+
+	fmt.Println()
+	fmt.Println("To get a JSONSchema per name, run with the --json-schema flag.")
+	return nil
+}
@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"flag"
+	"reflect"
+	"testing"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflow/v1"
+)
+
+func TestBuildInferQuery(t *testing.T) {
+	set := flag.NewFlagSet("infer-params", flag.ContinueOnError)
+	set.String("query", "", "")
+	set.String("since", "", "")
+	if err := set.Parse([]string{"--query", "ExecutionStatus='Completed'", "--since", "2024-05-01T12:00:00+02:00"}); err != nil {
+		t.Fatal(err)
+	}
+
+	query, err := buildInferQuery(cli.NewContext(cli.NewApp(), set, nil), `Order's\Workflow`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `WorkflowType='Order\'s\\Workflow' AND (ExecutionStatus='Completed') AND StartTime >= '2024-05-01T10:00:00Z'`
+	if query != want {
+		t.Errorf("query =\n%s\nwant\n%s", query, want)
+	}
+
+	if err := set.Set("since", "last tuesday"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := buildInferQuery(cli.NewContext(cli.NewApp(), set, nil), "ProcessOrder"); err == nil {
+		t.Errorf("invalid --since was accepted")
+	}
+}
+
+func TestFetchInputSamplesCanceled(t *testing.T) {
+	executions := []*workflow.WorkflowExecutionInfo{
+		{Execution: &common.WorkflowExecution{WorkflowId: "order-1", RunId: "run-1"}},
+		{Execution: &common.WorkflowExecution{WorkflowId: "order-2", RunId: "run-2"}},
+	}
+
+	// Without a client any fetch would panic, so none may start
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := fetchInputSamples(ctx, nil, executions, inferKindInput, 2)
+
+	for i, result := range results {
+		if result.Err != context.Canceled {
+			t.Errorf("result %d error = %v, want %v", i, result.Err, context.Canceled)
+		}
+		if result.WorkflowID != executions[i].Execution.WorkflowId {
+			t.Errorf("result %d workflow ID = %s", i, result.WorkflowID)
+		}
+	}
+}
+
+func TestGroupValuesByNameArguments(t *testing.T) {
+	payload := func(document string) *common.Payload {
+		return &common.Payload{Data: []byte(document)}
+	}
+
+	var sample inputSample
+	sample.add("ship", &common.Payloads{Payloads: []*common.Payload{payload(`{"orderId": "a"}`), payload(`"express"`)}})
+	sample.add("ship", &common.Payloads{Payloads: []*common.Payload{payload(`{"orderId": "b"}`), payload(`"ground"`)}})
+	sample.add("cancel", &common.Payloads{Payloads: []*common.Payload{payload(`{"reason": "late"}`)}})
+
+	groups := groupValuesByName([]inputSample{sample})
+	var labels []string
+	for _, group := range groups {
+		labels = append(labels, group.label())
+	}
+	if want := []string{"cancel", "ship (argument 1)", "ship (argument 2)"}; !reflect.DeepEqual(labels, want) {
+		t.Fatalf("groups = %v, want %v", labels, want)
+	}
+
+	// Each position has a schema of its own rather than a union of both
+	schema := argumentsSchema(groups[1:])
+	items := schema["items"].([]interface{})
+	if len(items) != 2 {
+		t.Fatalf("items = %v, want 2 positions", schema["items"])
+	}
+	if got := items[0].(map[string]interface{})["type"]; got != "object" {
+		t.Errorf("argument 1 type = %v, want object", got)
+	}
+	if got := items[1].(map[string]interface{})["type"]; got != "string" {
+		t.Errorf("argument 2 type = %v, want string", got)
+	}
+}
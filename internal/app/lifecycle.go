@@ -0,0 +1,116 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// stopResult is the structured output of the cancel and terminate commands
+type stopResult struct {
+	WorkflowID string      `json:"workflowId" yaml:"workflowId"`
+	RunID      string      `json:"runId,omitempty" yaml:"runId,omitempty"`
+	Action     string      `json:"action" yaml:"action"`
+	Reason     string      `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Details    interface{} `json:"details,omitempty" yaml:"details,omitempty"`
+}
+
+// describeTarget names the workflow run a command acts on, for prompts and messages
+func describeTarget(config TemporalConfig) string {
+	if config.RunID == "" {
+		return fmt.Sprintf("workflow %s", config.WorkflowID)
+	}
+	return fmt.Sprintf("workflow %s (run %s)", config.WorkflowID, config.RunID)
+}
+
+// cancelWorkflow requests cancellation of a workflow, which lets it clean up before closing
+func cancelWorkflow(c *cli.Context, config TemporalConfig) error {
+	if config.WorkflowID == "" {
+		return fmt.Errorf("workflow ID is required for canceling")
+	}
+
+	if !c.Bool("yes") && !confirmAction(fmt.Sprintf("Cancel %s", describeTarget(config))) {
+		return fmt.Errorf("cancel aborted by user")
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := temporalClient.CancelWorkflow(ctx, config.WorkflowID, config.RunID); err != nil {
+		return fmt.Errorf("failed to cancel workflow: %w", err)
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, stopResult{
+			WorkflowID: config.WorkflowID,
+			RunID:      config.RunID,
+			Action:     "cancel",
+		})
+	}
+
+	fmt.Printf("%sCancellation requested for %s%s\n", colorGreen, describeTarget(config), colorReset)
+	return nil
+}
+
+// terminateWorkflow forcefully closes a workflow without giving it a chance to clean up
+func terminateWorkflow(c *cli.Context, config TemporalConfig) error {
+	if config.WorkflowID == "" {
+		return fmt.Errorf("workflow ID is required for terminating")
+	}
+
+	var details []interface{}
+	if c.IsSet("details") {
+		var err error
+		details, err = parseInputArgs(config, []string{c.String("details")}, false, "details")
+		if err != nil {
+			return err
+		}
+	}
+
+	if !c.Bool("yes") && !confirmAction(fmt.Sprintf("Terminate %s", describeTarget(config))) {
+		return fmt.Errorf("terminate aborted by user")
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	reason := c.String("reason")
+	if err := temporalClient.TerminateWorkflow(ctx, config.WorkflowID, config.RunID, reason, details...); err != nil {
+		return fmt.Errorf("failed to terminate workflow: %w", err)
+	}
+
+	if isStructuredOutput(config) {
+		result := stopResult{
+			WorkflowID: config.WorkflowID,
+			RunID:      config.RunID,
+			Action:     "terminate",
+			Reason:     reason,
+		}
+		if len(details) > 0 {
+			result.Details = details[0]
+		}
+		return printStructured(config, result)
+	}
+
+	fmt.Printf("%sTerminated %s%s\n", colorRed, describeTarget(config), colorReset)
+	if reason != "" {
+		fmt.Printf("Reason: %s\n", reason)
+	}
+	return nil
+}
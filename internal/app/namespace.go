@@ -0,0 +1,537 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/replication/v1"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+// archivalSummary is the structured form of a namespace's archival settings
+type archivalSummary struct {
+	State string `json:"state" yaml:"state"`
+	URI   string `json:"uri,omitempty" yaml:"uri,omitempty"`
+}
+
+// namespaceSummary is the structured form of a namespace
+type namespaceSummary struct {
+	Name                   string            `json:"name" yaml:"name"`
+	ID                     string            `json:"id" yaml:"id"`
+	State                  string            `json:"state" yaml:"state"`
+	Description            string            `json:"description,omitempty" yaml:"description,omitempty"`
+	OwnerEmail             string            `json:"ownerEmail,omitempty" yaml:"ownerEmail,omitempty"`
+	Data                   map[string]string `json:"data,omitempty" yaml:"data,omitempty"`
+	Retention              string            `json:"retention" yaml:"retention"`
+	HistoryArchival        archivalSummary   `json:"historyArchival" yaml:"historyArchival"`
+	VisibilityArchival     archivalSummary   `json:"visibilityArchival" yaml:"visibilityArchival"`
+	CustomSearchAttributes map[string]string `json:"customSearchAttributes,omitempty" yaml:"customSearchAttributes,omitempty"`
+	SearchAttributeAliases map[string]string `json:"searchAttributeAliases,omitempty" yaml:"searchAttributeAliases,omitempty"`
+	IsGlobal               bool              `json:"isGlobal" yaml:"isGlobal"`
+	ActiveCluster          string            `json:"activeCluster,omitempty" yaml:"activeCluster,omitempty"`
+	Clusters               []string          `json:"clusters,omitempty" yaml:"clusters,omitempty"`
+	ReplicationState       string            `json:"replicationState,omitempty" yaml:"replicationState,omitempty"`
+	FailoverVersion        int64             `json:"failoverVersion" yaml:"failoverVersion"`
+}
+
+// namespaceResult is the structured output of register and update
+type namespaceResult struct {
+	Name      string `json:"name" yaml:"name"`
+	Retention string `json:"retention,omitempty" yaml:"retention,omitempty"`
+}
+
+// namespaceSettingFlags returns the flags shared by register and update
+func namespaceSettingFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "retention",
+			Usage: "How long closed workflows are kept, e.g. 30d or 72h",
+		},
+		&cli.StringFlag{
+			Name:  "description",
+			Usage: "Description of the namespace",
+		},
+		&cli.StringFlag{
+			Name:  "owner-email",
+			Usage: "Email address of the namespace owner",
+		},
+		&cli.StringSliceFlag{
+			Name:  "data",
+			Usage: "Custom data as key=value; repeat for multiple entries",
+		},
+		&cli.StringFlag{
+			Name:  "history-archival-state",
+			Usage: "History archival state: Enabled or Disabled",
+		},
+		&cli.StringFlag{
+			Name:  "history-archival-uri",
+			Usage: "URI history is archived to",
+		},
+		&cli.StringFlag{
+			Name:  "visibility-archival-state",
+			Usage: "Visibility archival state: Enabled or Disabled",
+		},
+		&cli.StringFlag{
+			Name:  "visibility-archival-uri",
+			Usage: "URI visibility records are archived to",
+		},
+		&cli.StringFlag{
+			Name:  "active-cluster",
+			Usage: "Name of the active cluster (for update, this fails the namespace over)",
+		},
+		&cli.StringSliceFlag{
+			Name:  "cluster",
+			Usage: "Cluster the namespace is replicated to; repeat for multiple clusters",
+		},
+	}
+}
+
+// newNamespaceCommand creates the command group for managing namespaces
+func newNamespaceCommand(config *TemporalConfig) *cli.Command {
+	return &cli.Command{
+		Name:  "namespace",
+		Usage: "List, describe, register and update namespaces",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "List namespaces",
+				Action: func(c *cli.Context) error {
+					return listNamespaces(c, *config)
+				},
+			},
+			{
+				Name:      "describe",
+				Usage:     "Show a namespace's settings, search attributes and replication config",
+				ArgsUsage: "[namespace]",
+				Action: func(c *cli.Context) error {
+					return describeNamespace(c, *config)
+				},
+			},
+			{
+				Name:      "register",
+				Usage:     "Register a new namespace",
+				ArgsUsage: "<namespace>",
+				Flags: append(namespaceSettingFlags(),
+					&cli.BoolFlag{
+						Name:  "global",
+						Usage: "Register a global namespace that can be replicated across clusters",
+					},
+				),
+				Action: func(c *cli.Context) error {
+					return registerNamespace(c, *config)
+				},
+			},
+			{
+				Name:      "update",
+				Usage:     "Update a namespace; only the given flags are changed",
+				ArgsUsage: "[namespace]",
+				Flags:     namespaceSettingFlags(),
+				Action: func(c *cli.Context) error {
+					return updateNamespace(c, *config)
+				},
+			},
+		},
+	}
+}
+
+// parseRetention parses a retention period. Besides Go durations it accepts a
+// number of days such as "30d", the unit retention is usually expressed in.
+func parseRetention(value string) (time.Duration, error) {
+	if days, found := strings.CutSuffix(value, "d"); found {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid retention '%s'", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid retention '%s': expected a duration like 30d or 72h", value)
+	}
+	return d, nil
+}
+
+// parseArchivalState parses an archival state flag, returning unspecified if it is not set
+func parseArchivalState(c *cli.Context, name string) (enums.ArchivalState, error) {
+	value := c.String(name)
+	if value == "" {
+		return enums.ARCHIVAL_STATE_UNSPECIFIED, nil
+	}
+	_, state, ok := lookupEnumName(enums.ArchivalState_value, value)
+	if !ok || state == int32(enums.ARCHIVAL_STATE_UNSPECIFIED) {
+		return enums.ARCHIVAL_STATE_UNSPECIFIED, fmt.Errorf("invalid --%s '%s': expected Enabled or Disabled", name, value)
+	}
+	return enums.ArchivalState(state), nil
+}
+
+// parseNamespaceData parses the --data key=value entries
+func parseNamespaceData(c *cli.Context) (map[string]string, error) {
+	entries := c.StringSlice("data")
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	data := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		key, value, found := strings.Cut(entry, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid data '%s': expected key=value", entry)
+		}
+		data[key] = value
+	}
+	return data, nil
+}
+
+// clusterConfigs converts the --cluster names into replication configs
+func clusterConfigs(c *cli.Context) []*replication.ClusterReplicationConfig {
+	var clusters []*replication.ClusterReplicationConfig
+	for _, name := range c.StringSlice("cluster") {
+		clusters = append(clusters, &replication.ClusterReplicationConfig{ClusterName: name})
+	}
+	return clusters
+}
+
+// namespaceArgument returns the namespace named on the command line, or the global --namespace
+func namespaceArgument(c *cli.Context, config TemporalConfig) string {
+	if name := c.Args().First(); name != "" {
+		return name
+	}
+	return config.Namespace
+}
+
+// newNamespaceSummary builds the structured form of a described namespace
+func newNamespaceSummary(resp *workflowservice.DescribeNamespaceResponse) namespaceSummary {
+	info := resp.GetNamespaceInfo()
+	nsConfig := resp.GetConfig()
+	replicationConfig := resp.GetReplicationConfig()
+
+	summary := namespaceSummary{
+		Name:        info.GetName(),
+		ID:          info.GetId(),
+		State:       enums.NamespaceState_name[int32(info.GetState())],
+		Description: info.GetDescription(),
+		OwnerEmail:  info.GetOwnerEmail(),
+		Data:        info.GetData(),
+		HistoryArchival: archivalSummary{
+			State: enums.ArchivalState_name[int32(nsConfig.GetHistoryArchivalState())],
+			URI:   nsConfig.GetHistoryArchivalUri(),
+		},
+		VisibilityArchival: archivalSummary{
+			State: enums.ArchivalState_name[int32(nsConfig.GetVisibilityArchivalState())],
+			URI:   nsConfig.GetVisibilityArchivalUri(),
+		},
+		SearchAttributeAliases: nsConfig.GetCustomSearchAttributeAliases(),
+		IsGlobal:               resp.GetIsGlobalNamespace(),
+		ActiveCluster:          replicationConfig.GetActiveClusterName(),
+		FailoverVersion:        resp.GetFailoverVersion(),
+	}
+
+	if retention := nsConfig.GetWorkflowExecutionRetentionTtl(); retention != nil {
+		summary.Retention = formatRetention(*retention)
+	}
+	for _, cluster := range replicationConfig.GetClusters() {
+		summary.Clusters = append(summary.Clusters, cluster.GetClusterName())
+	}
+	if state := replicationConfig.GetState(); state != 0 {
+		summary.ReplicationState = enums.ReplicationState_name[int32(state)]
+	}
+
+	return summary
+}
+
+// formatRetention renders a retention period in days when it is a whole number of them
+func formatRetention(d time.Duration) string {
+	day := 24 * time.Hour
+	if d > 0 && d%day == 0 {
+		return fmt.Sprintf("%dd", d/day)
+	}
+	return d.String()
+}
+
+// listNamespaces prints every namespace on the server
+func listNamespaces(c *cli.Context, config TemporalConfig) error {
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout, allowing for several pages of results
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var namespaces []namespaceSummary
+	var nextPageToken []byte
+	for {
+		resp, err := temporalClient.WorkflowService().ListNamespaces(ctx, &workflowservice.ListNamespacesRequest{
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list namespaces: %w", err)
+		}
+
+		for _, ns := range resp.GetNamespaces() {
+			namespaces = append(namespaces, newNamespaceSummary(ns))
+		}
+
+		nextPageToken = resp.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, namespaces)
+	}
+
+	fmt.Printf("Found %d namespaces:\n", len(namespaces))
+	for i, ns := range namespaces {
+		fmt.Printf("%d. Name: %s, State: %s, Retention: %s", i+1, ns.Name, ns.State, ns.Retention)
+		if ns.Description != "" {
+			fmt.Printf(", Description: %s", ns.Description)
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// describeNamespace prints the settings, search attributes and replication config of a namespace
+func describeNamespace(c *cli.Context, config TemporalConfig) error {
+	name := namespaceArgument(c, config)
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := temporalClient.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe namespace: %w", err)
+	}
+	summary := newNamespaceSummary(resp)
+
+	// Custom search attributes come from the operator service, which not every
+	// deployment exposes to every user, so the description works without them
+	attrs, err := temporalClient.OperatorService().ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
+		Namespace: name,
+	})
+	if err == nil {
+		summary.CustomSearchAttributes = make(map[string]string)
+		for attr, valueType := range attrs.GetCustomAttributes() {
+			summary.CustomSearchAttributes[attr] = enums.IndexedValueType_name[int32(valueType)]
+		}
+	} else if config.Debug {
+		fmt.Fprintf(os.Stderr, "Could not list search attributes: %v\n", err)
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, summary)
+	}
+
+	printNamespaceSummary(summary)
+	return nil
+}
+
+// printNamespaceSummary prints a described namespace
+func printNamespaceSummary(ns namespaceSummary) {
+	fmt.Printf("%s%s==== Namespace: %s ====%s\n", colorBold, colorBlue, ns.Name, colorReset)
+	fmt.Printf("ID: %s\n", ns.ID)
+	fmt.Printf("State: %s\n", ns.State)
+	if ns.Description != "" {
+		fmt.Printf("Description: %s\n", ns.Description)
+	}
+	if ns.OwnerEmail != "" {
+		fmt.Printf("Owner Email: %s\n", ns.OwnerEmail)
+	}
+	fmt.Printf("Retention: %s\n", ns.Retention)
+	printSortedMap("Data", ns.Data)
+
+	fmt.Printf("\n%sArchival:%s\n", colorBold, colorReset)
+	fmt.Printf("  History: %s %s\n", ns.HistoryArchival.State, ns.HistoryArchival.URI)
+	fmt.Printf("  Visibility: %s %s\n", ns.VisibilityArchival.State, ns.VisibilityArchival.URI)
+
+	if len(ns.CustomSearchAttributes) > 0 || len(ns.SearchAttributeAliases) > 0 {
+		fmt.Println()
+	}
+	printSortedMap("Custom Search Attributes", ns.CustomSearchAttributes)
+	printSortedMap("Search Attribute Aliases", ns.SearchAttributeAliases)
+
+	fmt.Printf("\n%sReplication:%s\n", colorBold, colorReset)
+	fmt.Printf("  Global: %v\n", ns.IsGlobal)
+	if ns.ActiveCluster != "" {
+		fmt.Printf("  Active Cluster: %s\n", ns.ActiveCluster)
+	}
+	if len(ns.Clusters) > 0 {
+		fmt.Printf("  Clusters: %s\n", strings.Join(ns.Clusters, ", "))
+	}
+	if ns.ReplicationState != "" {
+		fmt.Printf("  State: %s\n", ns.ReplicationState)
+	}
+	fmt.Printf("  Failover Version: %d\n", ns.FailoverVersion)
+}
+
+// printSortedMap prints a labeled map with its keys in order, or nothing if it is empty
+func printSortedMap(label string, values map[string]string) {
+	if len(values) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Printf("%s:\n", label)
+	for _, key := range keys {
+		fmt.Printf("  %s: %s\n", key, values[key])
+	}
+}
+
+// registerNamespace registers a new namespace
+func registerNamespace(c *cli.Context, config TemporalConfig) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("usage: tempural namespace register <namespace>")
+	}
+	name := c.Args().First()
+
+	retention := 72 * time.Hour
+	if c.IsSet("retention") {
+		var err error
+		if retention, err = parseRetention(c.String("retention")); err != nil {
+			return err
+		}
+	}
+
+	data, err := parseNamespaceData(c)
+	if err != nil {
+		return err
+	}
+	historyArchival, err := parseArchivalState(c, "history-archival-state")
+	if err != nil {
+		return err
+	}
+	visibilityArchival, err := parseArchivalState(c, "visibility-archival-state")
+	if err != nil {
+		return err
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = temporalClient.WorkflowService().RegisterNamespace(ctx, &workflowservice.RegisterNamespaceRequest{
+		Namespace:                        name,
+		Description:                      c.String("description"),
+		OwnerEmail:                       c.String("owner-email"),
+		WorkflowExecutionRetentionPeriod: &retention,
+		Clusters:                         clusterConfigs(c),
+		ActiveClusterName:                c.String("active-cluster"),
+		Data:                             data,
+		IsGlobalNamespace:                c.Bool("global"),
+		HistoryArchivalState:             historyArchival,
+		HistoryArchivalUri:               c.String("history-archival-uri"),
+		VisibilityArchivalState:          visibilityArchival,
+		VisibilityArchivalUri:            c.String("visibility-archival-uri"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register namespace: %w", err)
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, namespaceResult{Name: name, Retention: formatRetention(retention)})
+	}
+
+	fmt.Printf("%sRegistered namespace %s%s (retention %s)\n", colorGreen, name, colorReset, formatRetention(retention))
+	return nil
+}
+
+// updateNamespace changes the settings given on the command line, leaving the rest as they are
+func updateNamespace(c *cli.Context, config TemporalConfig) error {
+	name := namespaceArgument(c, config)
+
+	request := &workflowservice.UpdateNamespaceRequest{
+		Namespace: name,
+		UpdateInfo: &namespace.UpdateNamespaceInfo{
+			Description: c.String("description"),
+			OwnerEmail:  c.String("owner-email"),
+		},
+		Config: &namespace.NamespaceConfig{
+			HistoryArchivalUri:    c.String("history-archival-uri"),
+			VisibilityArchivalUri: c.String("visibility-archival-uri"),
+		},
+	}
+
+	var err error
+	if request.UpdateInfo.Data, err = parseNamespaceData(c); err != nil {
+		return err
+	}
+	if c.IsSet("retention") {
+		retention, err := parseRetention(c.String("retention"))
+		if err != nil {
+			return err
+		}
+		request.Config.WorkflowExecutionRetentionTtl = &retention
+	}
+	if request.Config.HistoryArchivalState, err = parseArchivalState(c, "history-archival-state"); err != nil {
+		return err
+	}
+	if request.Config.VisibilityArchivalState, err = parseArchivalState(c, "visibility-archival-state"); err != nil {
+		return err
+	}
+	if c.IsSet("active-cluster") || c.IsSet("cluster") {
+		request.ReplicationConfig = &replication.NamespaceReplicationConfig{
+			ActiveClusterName: c.String("active-cluster"),
+			Clusters:          clusterConfigs(c),
+		}
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := temporalClient.WorkflowService().UpdateNamespace(ctx, request); err != nil {
+		return fmt.Errorf("failed to update namespace: %w", err)
+	}
+
+	if isStructuredOutput(config) {
+		result := namespaceResult{Name: name}
+		if retention := request.Config.WorkflowExecutionRetentionTtl; retention != nil {
+			result.Retention = formatRetention(*retention)
+		}
+		return printStructured(config, result)
+	}
+
+	fmt.Printf("%sUpdated namespace %s%s\n", colorGreen, name, colorReset)
+	return nil
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflow/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats accepted by the --output flag
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputJSONL = "jsonl"
+	outputYAML  = "yaml"
+)
+
+// validateOutputFormat checks that format is one of the supported output formats
+func validateOutputFormat(format string) error {
+	switch format {
+	case outputTable, outputJSON, outputJSONL, outputYAML:
+		return nil
+	default:
+		return fmt.Errorf("unknown output format '%s' (valid formats: table, json, jsonl, yaml)", format)
+	}
+}
+
+// isStructuredOutput reports whether results should be printed as data instead of human-readable text
+func isStructuredOutput(config TemporalConfig) bool {
+	return config.Output != "" && config.Output != outputTable
+}
+
+// stdoutIsTerminal reports whether stdout is attached to a terminal
+func stdoutIsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// infof prints a progress or status message. With structured output the
+// message goes to stderr so that stdout only carries the result.
+func infof(config TemporalConfig, format string, a ...interface{}) {
+	if isStructuredOutput(config) {
+		fmt.Fprintf(os.Stderr, format, a...)
+		return
+	}
+	fmt.Printf(format, a...)
+}
+
+// printStructured writes v to stdout in the configured output format.
+// With jsonl, each element of a slice is written on its own line.
+func printStructured(config TemporalConfig, v interface{}) error {
+	switch config.Output {
+	case outputJSONL:
+		encoder := json.NewEncoder(os.Stdout)
+		value := reflect.ValueOf(v)
+		if value.Kind() != reflect.Slice {
+			return encoder.Encode(v)
+		}
+		for i := 0; i < value.Len(); i++ {
+			if err := encoder.Encode(value.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case outputYAML:
+		encoder := yaml.NewEncoder(os.Stdout)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(v); err != nil {
+			return err
+		}
+		return encoder.Close()
+
+	default:
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(v)
+	}
+}
+
+// workflowSummary is the structured form of a workflow execution
+type workflowSummary struct {
+	WorkflowID string     `json:"workflowId" yaml:"workflowId"`
+	RunID      string     `json:"runId" yaml:"runId"`
+	Type       string     `json:"type" yaml:"type"`
+	Status     string     `json:"status" yaml:"status"`
+	TaskQueue  string     `json:"taskQueue,omitempty" yaml:"taskQueue,omitempty"`
+	StartTime  *time.Time `json:"startTime,omitempty" yaml:"startTime,omitempty"`
+	CloseTime  *time.Time `json:"closeTime,omitempty" yaml:"closeTime,omitempty"`
+}
+
+// newWorkflowSummary builds a workflowSummary from visibility info
+func newWorkflowSummary(execution *workflow.WorkflowExecutionInfo) workflowSummary {
+	return workflowSummary{
+		WorkflowID: execution.Execution.WorkflowId,
+		RunID:      execution.Execution.RunId,
+		Type:       execution.Type.Name,
+		Status:     enums.WorkflowExecutionStatus_name[int32(execution.Status)],
+		TaskQueue:  execution.TaskQueue,
+		StartTime:  execution.StartTime,
+		CloseTime:  execution.CloseTime,
+	}
+}
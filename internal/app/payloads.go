@@ -0,0 +1,161 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+)
+
+// readInputValue returns value, or the contents of stdin when value is "-".
+// Empty stdin falls back to an empty JSON object.
+func readInputValue(config TemporalConfig, value, description string) (string, error) {
+	if value != "-" {
+		return value, nil
+	}
+
+	infof(config, "Reading %s from stdin...\n", description)
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return "", fmt.Errorf("error reading from stdin: %w", err)
+	}
+
+	input := string(data)
+	if strings.TrimSpace(input) == "" {
+		infof(config, "Warning: Empty %s received from stdin\n", description)
+		input = "{}" // Fallback to empty JSON object
+	}
+
+	return input, nil
+}
+
+// parseJSONValue decodes a single JSON document. Numbers are kept as
+// json.Number so that large integers such as IDs survive the round trip.
+func parseJSONValue(input string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(input))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+
+	return value, nil
+}
+
+// parseInputArgs turns JSON inputs into the arguments passed to the SDK, which
+// encodes each one as a json/plain payload. Every input becomes one argument,
+// unless spread is set, in which case a single input must be a JSON array whose
+// elements become the arguments.
+func parseInputArgs(config TemporalConfig, inputs []string, spread bool, description string) ([]interface{}, error) {
+	if spread && len(inputs) != 1 {
+		return nil, fmt.Errorf("exactly one %s is required when spreading a JSON array into arguments", description)
+	}
+
+	// Stdin can only be read once, so a second "-" would silently be empty
+	fromStdin := 0
+	for _, input := range inputs {
+		if input == "-" {
+			fromStdin++
+		}
+	}
+	if fromStdin > 1 {
+		return nil, fmt.Errorf("only one %s can be read from stdin", description)
+	}
+
+	args := make([]interface{}, 0, len(inputs))
+	for i, input := range inputs {
+		raw, err := readInputValue(config, input, description)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := parseJSONValue(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s %d is not valid JSON: %w", description, i+1, err)
+		}
+
+		if spread {
+			elements, ok := value.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s must be a JSON array when spreading it into arguments", description)
+			}
+			return elements, nil
+		}
+
+		args = append(args, value)
+	}
+
+	return args, nil
+}
+
+// signalWithArgs sends a signal carrying any number of arguments.
+// Client.SignalWorkflow only accepts a single argument, so other argument
+// counts go through the workflow service directly.
+func signalWithArgs(ctx context.Context, temporalClient client.Client, config TemporalConfig, workflowID, runID, signalName string, args []interface{}) error {
+	if len(args) == 1 {
+		return temporalClient.SignalWorkflow(ctx, workflowID, runID, signalName, args[0])
+	}
+
+	input, err := converter.GetDefaultDataConverter().ToPayloads(args...)
+	if err != nil {
+		return fmt.Errorf("failed to encode signal input: %w", err)
+	}
+
+	_, err = temporalClient.WorkflowService().SignalWorkflowExecution(ctx, &workflowservice.SignalWorkflowExecutionRequest{
+		Namespace: config.Namespace,
+		WorkflowExecution: &common.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		SignalName: signalName,
+		Input:      input,
+	})
+	return err
+}
+
+// decodePayload returns the payload data parsed as JSON, or as a plain string
+// if it isn't valid JSON. Empty payloads decode to nil.
+func decodePayload(payload *common.Payload) interface{} {
+	data := payload.GetData()
+	if len(data) == 0 {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return string(data)
+	}
+	return value
+}
+
+// decodePayloads decodes every payload in payloads with decodePayload
+func decodePayloads(payloads *common.Payloads) []interface{} {
+	values := make([]interface{}, 0, len(payloads.GetPayloads()))
+	for _, payload := range payloads.GetPayloads() {
+		values = append(values, decodePayload(payload))
+	}
+	return values
+}
+
+// formatJSON renders a value as indented JSON for display
+func formatJSON(value interface{}) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return strings.TrimRight(buf.String(), "\n")
+}
@@ -0,0 +1,60 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseInputArgs(t *testing.T) {
+	config := TemporalConfig{}
+
+	args, err := parseInputArgs(config, []string{`{"orderId": 12345678901234567890}`, `"rush"`}, false, "input")
+	if err != nil {
+		t.Fatalf("parseInputArgs() returned error: %v", err)
+	}
+	if len(args) != 2 {
+		t.Fatalf("parseInputArgs() returned %d args, want 2", len(args))
+	}
+
+	// Large integers must not lose precision when encoded again
+	encoded, _ := json.Marshal(args[0])
+	if string(encoded) != `{"orderId":12345678901234567890}` {
+		t.Errorf("first arg encodes to %s, want the original number", encoded)
+	}
+	if args[1] != "rush" {
+		t.Errorf("second arg = %v, want %q", args[1], "rush")
+	}
+}
+
+func TestParseInputArgsSpread(t *testing.T) {
+	config := TemporalConfig{}
+
+	args, err := parseInputArgs(config, []string{`[{"a": 1}, "b", true]`}, true, "input")
+	if err != nil {
+		t.Fatalf("parseInputArgs() returned error: %v", err)
+	}
+	if len(args) != 3 {
+		t.Errorf("parseInputArgs() returned %d args, want 3", len(args))
+	}
+
+	if _, err := parseInputArgs(config, []string{`{"a": 1}`}, true, "input"); err == nil {
+		t.Error("parseInputArgs() should reject a non-array input when spreading")
+	}
+}
+
+func TestParseInputArgsInvalidJSON(t *testing.T) {
+	config := TemporalConfig{}
+
+	for _, input := range []string{`{`, `{} {}`, `not json`} {
+		if _, err := parseInputArgs(config, []string{input}, false, "input"); err == nil {
+			t.Errorf("parseInputArgs(%q) should return an error", input)
+		}
+	}
+}
+
+func TestParseInputArgsStdinOnce(t *testing.T) {
+	// Rejected before stdin is read, so the test doesn't block on it
+	if _, err := parseInputArgs(TemporalConfig{}, []string{"-", `"rush"`, "-"}, false, "input"); err == nil {
+		t.Errorf("parseInputArgs() accepted stdin twice")
+	}
+}
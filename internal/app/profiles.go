@@ -0,0 +1,347 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// profileKeys lists the settings a profile can hold. Each key matches the
+// name of the global flag it provides a value for.
+var profileKeys = []string{
+	"address",
+	"namespace",
+	"task-queue",
+	"workflow-id",
+	"tls",
+	"tls-ca",
+	"tls-cert",
+	"tls-key",
+	"tls-server-name",
+	"tls-insecure-skip-verify",
+	"output",
+	"debug",
+}
+
+// Profile is a named set of settings, keyed by global flag name
+type Profile map[string]string
+
+// ConfigFile is the on-disk format of the tempural config file
+type ConfigFile struct {
+	CurrentProfile string             `yaml:"current-profile,omitempty"`
+	Profiles       map[string]Profile `yaml:"profiles,omitempty"`
+}
+
+// defaultConfigPath returns the location of the config file when none is given
+func defaultConfigPath() string {
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return filepath.Join(".config", "tempural", "config.yaml")
+		}
+		configDir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configDir, "tempural", "config.yaml")
+}
+
+// loadConfigFile reads the config file at path. A missing file is not an
+// error and results in an empty config.
+func loadConfigFile(path string) (*ConfigFile, error) {
+	file := &ConfigFile{Profiles: make(map[string]Profile)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return file, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read config file: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("could not parse config file %s: %w", path, err)
+	}
+	if file.Profiles == nil {
+		file.Profiles = make(map[string]Profile)
+	}
+
+	return file, nil
+}
+
+// saveConfigFile writes the config file to path, creating its directory if needed
+func saveConfigFile(path string, file *ConfigFile) error {
+	data, err := yaml.Marshal(file)
+	if err != nil {
+		return fmt.Errorf("could not encode config file: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("could not create config directory: %w", err)
+	}
+
+	// The file may reference key material, so keep it private to the user
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("could not write config file: %w", err)
+	}
+
+	return nil
+}
+
+// setConfigValue assigns a profile setting to the matching TemporalConfig field
+func setConfigValue(config *TemporalConfig, key, value string) error {
+	switch key {
+	case "address":
+		config.Address = value
+	case "namespace":
+		config.Namespace = value
+	case "task-queue":
+		config.TaskQueue = value
+	case "workflow-id":
+		config.WorkflowID = value
+	case "tls-ca":
+		config.TLSCACert = value
+	case "tls-cert":
+		config.TLSCert = value
+	case "tls-key":
+		config.TLSKey = value
+	case "tls-server-name":
+		config.TLSServerName = value
+	case "output":
+		if err := validateOutputFormat(value); err != nil {
+			return err
+		}
+		config.Output = value
+	case "tls", "tls-insecure-skip-verify", "debug":
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value %q for %s: expected true or false", value, key)
+		}
+		switch key {
+		case "tls":
+			config.TLS = enabled
+		case "tls-insecure-skip-verify":
+			config.TLSInsecureSkipVerify = enabled
+		case "debug":
+			config.Debug = enabled
+		}
+	default:
+		return fmt.Errorf("unknown profile setting %q (valid settings: %v)", key, profileKeys)
+	}
+	return nil
+}
+
+// applyProfile fills in config from the selected profile. Settings are
+// resolved in this order: command-line flags, environment variables, the
+// profile, and finally the built-in flag defaults.
+func applyProfile(c *cli.Context, config *TemporalConfig) error {
+	file, err := loadConfigFile(config.ConfigFile)
+	if err != nil {
+		return err
+	}
+
+	name := config.Profile
+	if name == "" {
+		name = file.CurrentProfile
+	}
+	if name == "" {
+		return nil
+	}
+
+	profile, ok := file.Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile '%s' not found in %s", name, config.ConfigFile)
+	}
+
+	for _, key := range profileKeys {
+		value, ok := profile[key]
+		if !ok || c.IsSet(key) {
+			continue
+		}
+		if err := setConfigValue(config, key, value); err != nil {
+			return fmt.Errorf("profile '%s': %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// newConfigCommand creates the command group for managing connection profiles
+func newConfigCommand(config *TemporalConfig) *cli.Command {
+	return &cli.Command{
+		Name:  "config",
+		Usage: "Manage named connection profiles",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "List the profiles in the config file",
+				Action: func(c *cli.Context) error {
+					return listProfiles(c, *config)
+				},
+			},
+			{
+				Name:      "use",
+				Usage:     "Set the profile used when --profile is not given",
+				ArgsUsage: "<profile>",
+				Action: func(c *cli.Context) error {
+					return useProfile(c, *config)
+				},
+			},
+			{
+				Name:      "set",
+				Usage:     "Set a value in a profile (the --profile profile, or the current one)",
+				ArgsUsage: "<key> <value>",
+				Action: func(c *cli.Context) error {
+					return setProfileValue(c, *config)
+				},
+			},
+			{
+				Name:      "show",
+				Usage:     "Show the settings stored in a profile",
+				ArgsUsage: "[profile]",
+				Action: func(c *cli.Context) error {
+					return showProfile(c, *config)
+				},
+			},
+		},
+	}
+}
+
+// listProfiles prints the names of all profiles, marking the current one
+func listProfiles(c *cli.Context, config TemporalConfig) error {
+	file, err := loadConfigFile(config.ConfigFile)
+	if err != nil {
+		return err
+	}
+
+	if len(file.Profiles) == 0 {
+		fmt.Printf("No profiles found in %s\n", config.ConfigFile)
+		return nil
+	}
+
+	names := make([]string, 0, len(file.Profiles))
+	for name := range file.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if name == file.CurrentProfile {
+			fmt.Printf("* %s%s%s\n", colorBold, name, colorReset)
+		} else {
+			fmt.Printf("  %s\n", name)
+		}
+	}
+
+	return nil
+}
+
+// useProfile makes the named profile the current one
+func useProfile(c *cli.Context, config TemporalConfig) error {
+	if c.NArg() != 1 {
+		return fmt.Errorf("usage: tempural config use <profile>")
+	}
+	name := c.Args().First()
+
+	file, err := loadConfigFile(config.ConfigFile)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := file.Profiles[name]; !ok {
+		return fmt.Errorf("profile '%s' not found in %s", name, config.ConfigFile)
+	}
+
+	file.CurrentProfile = name
+	if err := saveConfigFile(config.ConfigFile, file); err != nil {
+		return err
+	}
+
+	fmt.Printf("Now using profile '%s'\n", name)
+	return nil
+}
+
+// setProfileValue stores a single setting in a profile, creating the profile if needed
+func setProfileValue(c *cli.Context, config TemporalConfig) error {
+	if c.NArg() != 2 {
+		return fmt.Errorf("usage: tempural config set <key> <value>")
+	}
+	key := c.Args().Get(0)
+	value := c.Args().Get(1)
+
+	// Validate the setting before writing it to the file
+	if err := setConfigValue(&TemporalConfig{}, key, value); err != nil {
+		return err
+	}
+
+	file, err := loadConfigFile(config.ConfigFile)
+	if err != nil {
+		return err
+	}
+
+	name := config.Profile
+	if name == "" {
+		name = file.CurrentProfile
+	}
+	if name == "" {
+		name = "default"
+	}
+
+	profile, ok := file.Profiles[name]
+	if !ok {
+		profile = make(Profile)
+		file.Profiles[name] = profile
+	}
+	profile[key] = value
+
+	// The first profile created becomes the current one
+	if file.CurrentProfile == "" {
+		file.CurrentProfile = name
+	}
+
+	if err := saveConfigFile(config.ConfigFile, file); err != nil {
+		return err
+	}
+
+	fmt.Printf("Set %s=%s in profile '%s'\n", key, value, name)
+	return nil
+}
+
+// showProfile prints the settings stored in a profile
+func showProfile(c *cli.Context, config TemporalConfig) error {
+	file, err := loadConfigFile(config.ConfigFile)
+	if err != nil {
+		return err
+	}
+
+	name := c.Args().First()
+	if name == "" {
+		name = config.Profile
+	}
+	if name == "" {
+		name = file.CurrentProfile
+	}
+	if name == "" {
+		return fmt.Errorf("no profile selected; pass a profile name or run 'tempural config use <profile>'")
+	}
+
+	profile, ok := file.Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile '%s' not found in %s", name, config.ConfigFile)
+	}
+
+	fmt.Printf("%s%s==== Profile: %s ====%s\n", colorBold, colorBlue, name, colorReset)
+	for _, key := range profileKeys {
+		if value, ok := profile[key]; ok {
+			fmt.Printf("%s: %s\n", key, value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// Reset types pick a reset point by position instead of by event ID
+const (
+	resetLastWorkflowTask   = "LastWorkflowTask"
+	resetFirstWorkflowTask  = "FirstWorkflowTask"
+	resetLastContinuedAsNew = "LastContinuedAsNew"
+)
+
+// resetTypeValues lets lookupEnumName parse reset types like API enum names
+var resetTypeValues = map[string]int32{
+	resetLastWorkflowTask:   0,
+	resetFirstWorkflowTask:  1,
+	resetLastContinuedAsNew: 2,
+}
+
+// resetPoint is a completed workflow task that a run can be reset to
+type resetPoint struct {
+	EventID        int64      `json:"eventId" yaml:"eventId"`
+	EventTime      *time.Time `json:"eventTime,omitempty" yaml:"eventTime,omitempty"`
+	Identity       string     `json:"identity,omitempty" yaml:"identity,omitempty"`
+	BinaryChecksum string     `json:"binaryChecksum,omitempty" yaml:"binaryChecksum,omitempty"`
+}
+
+// resetResult is the structured output of the reset command
+type resetResult struct {
+	WorkflowID  string `json:"workflowId" yaml:"workflowId"`
+	RunID       string `json:"runId" yaml:"runId"`
+	EventID     int64  `json:"eventId" yaml:"eventId"`
+	NewRunID    string `json:"newRunId" yaml:"newRunId"`
+	Reason      string `json:"reason" yaml:"reason"`
+	ReapplyType string `json:"reapplyType" yaml:"reapplyType"`
+}
+
+// findResetPoints returns the completed workflow tasks in a run's history, in
+// order, and the ID of the run it was continued from, if any
+func findResetPoints(ctx context.Context, temporalClient client.Client, workflowID, runID string) ([]resetPoint, string, error) {
+	var points []resetPoint
+	var previousRunID string
+
+	iter := temporalClient.GetWorkflowHistory(ctx, workflowID, runID, false, enums.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get workflow history: %w", err)
+		}
+
+		switch event.GetEventType() {
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+			previousRunID = event.GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunId()
+		case enums.EVENT_TYPE_WORKFLOW_TASK_COMPLETED:
+			attrs := event.GetWorkflowTaskCompletedEventAttributes()
+			points = append(points, resetPoint{
+				EventID:        event.GetEventId(),
+				EventTime:      event.GetEventTime(),
+				Identity:       attrs.GetIdentity(),
+				BinaryChecksum: attrs.GetBinaryChecksum(),
+			})
+		}
+	}
+
+	return points, previousRunID, nil
+}
+
+// resetWorkflow resets a workflow run to an earlier workflow task, starting a
+// new run that replays history up to that point
+func resetWorkflow(c *cli.Context, config TemporalConfig) error {
+	if config.WorkflowID == "" {
+		return fmt.Errorf("workflow ID is required for resetting")
+	}
+
+	listOnly := c.Bool("list")
+	if !listOnly {
+		if c.IsSet("event-id") == c.IsSet("type") {
+			return fmt.Errorf("exactly one of --event-id or --type is required (or --list to show reset points)")
+		}
+		if c.String("reason") == "" {
+			return fmt.Errorf("--reason is required for resetting")
+		}
+	}
+
+	reapplyName, reapplyValue, ok := lookupEnumName(enums.ResetReapplyType_value, c.String("reapply-type"))
+	if !ok || reapplyValue == int32(enums.RESET_REAPPLY_TYPE_UNSPECIFIED) {
+		return fmt.Errorf("unknown reapply type '%s' (expected Signal or None)", c.String("reapply-type"))
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Histories can span many pages, so allow more time than a single call
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Pin the run so the event IDs we find belong to the run we reset
+	resp, err := temporalClient.DescribeWorkflowExecution(ctx, config.WorkflowID, config.RunID)
+	if err != nil {
+		return fmt.Errorf("failed to describe workflow: %w", err)
+	}
+	runID := resp.WorkflowExecutionInfo.Execution.RunId
+
+	points, previousRunID, err := findResetPoints(ctx, temporalClient, config.WorkflowID, runID)
+	if err != nil {
+		return err
+	}
+
+	if listOnly {
+		return printResetPoints(config, runID, points, previousRunID)
+	}
+
+	eventID := c.Int64("event-id")
+	if c.IsSet("type") {
+		resetType, _, ok := lookupEnumName(resetTypeValues, c.String("type"))
+		if !ok {
+			return fmt.Errorf("unknown reset type '%s' (expected %s, %s or %s)", c.String("type"),
+				resetLastWorkflowTask, resetFirstWorkflowTask, resetLastContinuedAsNew)
+		}
+
+		// The last continued-as-new point lives in the previous run
+		if resetType == resetLastContinuedAsNew {
+			if previousRunID == "" {
+				return fmt.Errorf("run %s was not continued from a previous run", runID)
+			}
+			runID = previousRunID
+			if points, _, err = findResetPoints(ctx, temporalClient, config.WorkflowID, runID); err != nil {
+				return err
+			}
+		}
+
+		if len(points) == 0 {
+			return fmt.Errorf("run %s has no completed workflow tasks to reset to", runID)
+		}
+		if resetType == resetFirstWorkflowTask {
+			eventID = points[0].EventID
+		} else {
+			eventID = points[len(points)-1].EventID
+		}
+	}
+
+	target := fmt.Sprintf("workflow %s (run %s) to event %d", config.WorkflowID, runID, eventID)
+	if !c.Bool("yes") && !confirmAction(fmt.Sprintf("Reset %s", target)) {
+		return fmt.Errorf("reset aborted by user")
+	}
+
+	reason := c.String("reason")
+	resetResp, err := temporalClient.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
+		Namespace: config.Namespace,
+		WorkflowExecution: &common.WorkflowExecution{
+			WorkflowId: config.WorkflowID,
+			RunId:      runID,
+		},
+		Reason:                    reason,
+		WorkflowTaskFinishEventId: eventID,
+		RequestId:                 uuid.NewString(),
+		ResetReapplyType:          enums.ResetReapplyType(reapplyValue),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset workflow: %w", err)
+	}
+
+	result := resetResult{
+		WorkflowID:  config.WorkflowID,
+		RunID:       runID,
+		EventID:     eventID,
+		NewRunID:    resetResp.GetRunId(),
+		Reason:      reason,
+		ReapplyType: reapplyName,
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, result)
+	}
+
+	fmt.Printf("%sReset %s%s\n", colorGreen, target, colorReset)
+	fmt.Printf("New Run ID: %s\n", result.NewRunID)
+	fmt.Printf("Reapply: %s\n", result.ReapplyType)
+	return nil
+}
+
+// printResetPoints prints the completed workflow tasks a run can be reset to
+func printResetPoints(config TemporalConfig, runID string, points []resetPoint, previousRunID string) error {
+	if isStructuredOutput(config) {
+		return printStructured(config, points)
+	}
+
+	fmt.Printf("%s%s==== Reset Points: %s (run %s) ====%s\n", colorBold, colorBlue, config.WorkflowID, runID, colorReset)
+	if len(points) == 0 {
+		fmt.Println("No completed workflow tasks found")
+	}
+
+	for i, point := range points {
+		label := ""
+		switch {
+		case len(points) == 1:
+			label = fmt.Sprintf(" (%s, %s)", resetFirstWorkflowTask, resetLastWorkflowTask)
+		case i == 0:
+			label = fmt.Sprintf(" (%s)", resetFirstWorkflowTask)
+		case i == len(points)-1:
+			label = fmt.Sprintf(" (%s)", resetLastWorkflowTask)
+		}
+
+		fmt.Printf("%sEvent %d%s%s  %s", colorBold, point.EventID, colorReset, label, formatEventTime(point.EventTime))
+		if point.Identity != "" {
+			fmt.Printf("  identity=%s", point.Identity)
+		}
+		if point.BinaryChecksum != "" {
+			fmt.Printf("  checksum=%s", point.BinaryChecksum)
+		}
+		fmt.Println()
+	}
+
+	if previousRunID != "" {
+		fmt.Printf("\nContinued from run %s; use --type %s to reset it\n", previousRunID, resetLastContinuedAsNew)
+	}
+
+	return nil
+}
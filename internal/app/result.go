@@ -0,0 +1,119 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+)
+
+// failureInfo is the structured form of one error in a failure chain
+type failureInfo struct {
+	Type    string `json:"type" yaml:"type"`
+	Message string `json:"message" yaml:"message"`
+}
+
+// workflowOutcome is the structured form of a finished workflow run
+type workflowOutcome struct {
+	Status  string        `json:"status" yaml:"status"`
+	Result  interface{}   `json:"result,omitempty" yaml:"result,omitempty"`
+	Failure []failureInfo `json:"failure,omitempty" yaml:"failure,omitempty"`
+}
+
+// waitForWorkflowRun blocks until the run closes or timeout expires (0 waits
+// forever). The returned error is non-nil if the workflow did not complete
+// successfully. The outcome has no status if the run's outcome is unknown.
+func waitForWorkflowRun(run client.WorkflowRun, timeout time.Duration) (workflowOutcome, error) {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var result interface{}
+	err := run.Get(ctx, &result)
+	if err == nil {
+		return workflowOutcome{Status: "Completed", Result: result}, nil
+	}
+
+	if ctx.Err() == context.DeadlineExceeded {
+		return workflowOutcome{}, fmt.Errorf("timed out after %v waiting for workflow result", timeout)
+	}
+
+	var executionErr *temporal.WorkflowExecutionError
+	if !errors.As(err, &executionErr) {
+		return workflowOutcome{}, fmt.Errorf("failed to get workflow result: %w", err)
+	}
+
+	outcome := workflowOutcome{
+		Status:  workflowErrorStatus(err),
+		Failure: failureChain(err),
+	}
+	return outcome, fmt.Errorf("workflow %s", strings.ToLower(outcome.Status))
+}
+
+// workflowErrorStatus maps the error returned for a closed workflow to its close status
+func workflowErrorStatus(err error) string {
+	// The SDK wraps the close reason in a WorkflowExecutionError
+	cause := errors.Unwrap(err)
+	if cause == nil {
+		cause = err
+	}
+
+	switch cause.(type) {
+	case *temporal.TerminatedError:
+		return "Terminated"
+	case *temporal.CanceledError:
+		return "Canceled"
+	case *temporal.TimeoutError:
+		return "TimedOut"
+	default:
+		return "Failed"
+	}
+}
+
+// failureChain splits an error and its causes into one entry per error. Each
+// entry only holds that error's own message, not the messages of its causes.
+func failureChain(err error) []failureInfo {
+	var chain []failureInfo
+	for current := err; current != nil; current = errors.Unwrap(current) {
+		message := current.Error()
+		if next := errors.Unwrap(current); next != nil {
+			message = strings.TrimSuffix(message, ": "+next.Error())
+		}
+
+		chain = append(chain, failureInfo{
+			Type:    strings.TrimPrefix(fmt.Sprintf("%T", current), "*"),
+			Message: message,
+		})
+	}
+	return chain
+}
+
+// printWorkflowOutcome prints the result or failure chain of a finished workflow run
+func printWorkflowOutcome(outcome workflowOutcome) {
+	switch outcome.Status {
+	case "Completed":
+		fmt.Printf("%sWorkflow completed%s\n", colorGreen, colorReset)
+		fmt.Printf("Result:\n%s\n", formatJSON(outcome.Result))
+	default:
+		fmt.Printf("%sWorkflow %s%s\n", colorRed, strings.ToLower(outcome.Status), colorReset)
+		printFailureChain(outcome.Failure)
+	}
+}
+
+// printFailureChain prints a failure chain, indenting each cause below its parent
+func printFailureChain(chain []failureInfo) {
+	for depth, failure := range chain {
+		prefix := strings.Repeat("  ", depth)
+		if depth > 0 {
+			prefix += "caused by: "
+		}
+		fmt.Printf("%s%s%s%s: %s\n", prefix, colorBold, failure.Type, colorReset, failure.Message)
+	}
+}
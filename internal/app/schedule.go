@@ -0,0 +1,1056 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// scheduleSpecSummary is the structured form of when a schedule runs
+type scheduleSpecSummary struct {
+	Cron      []string   `json:"cron,omitempty" yaml:"cron,omitempty"`
+	Intervals []string   `json:"intervals,omitempty" yaml:"intervals,omitempty"`
+	Calendars []string   `json:"calendars,omitempty" yaml:"calendars,omitempty"`
+	TimeZone  string     `json:"timeZone,omitempty" yaml:"timeZone,omitempty"`
+	Jitter    string     `json:"jitter,omitempty" yaml:"jitter,omitempty"`
+	StartAt   *time.Time `json:"startAt,omitempty" yaml:"startAt,omitempty"`
+	EndAt     *time.Time `json:"endAt,omitempty" yaml:"endAt,omitempty"`
+}
+
+// scheduleActionSummary is the structured form of the workflow a schedule starts
+type scheduleActionSummary struct {
+	WorkflowID       string        `json:"workflowId" yaml:"workflowId"`
+	WorkflowType     string        `json:"workflowType" yaml:"workflowType"`
+	TaskQueue        string        `json:"taskQueue" yaml:"taskQueue"`
+	Input            []interface{} `json:"input,omitempty" yaml:"input,omitempty"`
+	ExecutionTimeout string        `json:"executionTimeout,omitempty" yaml:"executionTimeout,omitempty"`
+	RunTimeout       string        `json:"runTimeout,omitempty" yaml:"runTimeout,omitempty"`
+	TaskTimeout      string        `json:"taskTimeout,omitempty" yaml:"taskTimeout,omitempty"`
+}
+
+// scheduleRun is the structured form of a workflow started by a schedule
+type scheduleRun struct {
+	ScheduleTime time.Time `json:"scheduleTime" yaml:"scheduleTime"`
+	ActualTime   time.Time `json:"actualTime" yaml:"actualTime"`
+	WorkflowID   string    `json:"workflowId,omitempty" yaml:"workflowId,omitempty"`
+	RunID        string    `json:"runId,omitempty" yaml:"runId,omitempty"`
+}
+
+// scheduleRef is the structured output of commands that change a schedule
+type scheduleRef struct {
+	ID string `json:"id" yaml:"id"`
+}
+
+// scheduleStateResult is the structured output of pause and unpause
+type scheduleStateResult struct {
+	ID     string `json:"id" yaml:"id"`
+	Paused bool   `json:"paused" yaml:"paused"`
+	Note   string `json:"note,omitempty" yaml:"note,omitempty"`
+}
+
+// scheduleBackfillResult is the structured output of backfill
+type scheduleBackfillResult struct {
+	ID        string    `json:"id" yaml:"id"`
+	StartTime time.Time `json:"startTime" yaml:"startTime"`
+	EndTime   time.Time `json:"endTime" yaml:"endTime"`
+}
+
+// scheduleSummary is the structured form of a schedule in the list command
+type scheduleSummary struct {
+	ID           string              `json:"id" yaml:"id"`
+	WorkflowType string              `json:"workflowType,omitempty" yaml:"workflowType,omitempty"`
+	Paused       bool                `json:"paused" yaml:"paused"`
+	Note         string              `json:"note,omitempty" yaml:"note,omitempty"`
+	Spec         scheduleSpecSummary `json:"spec" yaml:"spec"`
+	NextRunTime  *time.Time          `json:"nextRunTime,omitempty" yaml:"nextRunTime,omitempty"`
+	LastRunTime  *time.Time          `json:"lastRunTime,omitempty" yaml:"lastRunTime,omitempty"`
+}
+
+// scheduleDescription is the structured form of a described schedule
+type scheduleDescription struct {
+	ID               string                 `json:"id" yaml:"id"`
+	Spec             scheduleSpecSummary    `json:"spec" yaml:"spec"`
+	Action           *scheduleActionSummary `json:"action,omitempty" yaml:"action,omitempty"`
+	Overlap          string                 `json:"overlap,omitempty" yaml:"overlap,omitempty"`
+	CatchupWindow    string                 `json:"catchupWindow,omitempty" yaml:"catchupWindow,omitempty"`
+	PauseOnFailure   bool                   `json:"pauseOnFailure" yaml:"pauseOnFailure"`
+	Paused           bool                   `json:"paused" yaml:"paused"`
+	Note             string                 `json:"note,omitempty" yaml:"note,omitempty"`
+	RemainingActions *int                   `json:"remainingActions,omitempty" yaml:"remainingActions,omitempty"`
+	NumActions       int                    `json:"numActions" yaml:"numActions"`
+	RunningWorkflows []scheduleRun          `json:"runningWorkflows,omitempty" yaml:"runningWorkflows,omitempty"`
+	RecentActions    []scheduleRun          `json:"recentActions,omitempty" yaml:"recentActions,omitempty"`
+	NextActionTimes  []time.Time            `json:"nextActionTimes,omitempty" yaml:"nextActionTimes,omitempty"`
+	CreatedAt        time.Time              `json:"createdAt" yaml:"createdAt"`
+	LastUpdateAt     *time.Time             `json:"lastUpdateAt,omitempty" yaml:"lastUpdateAt,omitempty"`
+}
+
+// scheduleIDFlag returns the flag naming the schedule a subcommand acts on
+func scheduleIDFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:     "schedule-id",
+		Aliases:  []string{"s"},
+		Usage:    "ID of the schedule",
+		Required: true,
+	}
+}
+
+// overlapPolicyFlag returns the flag for choosing what happens when runs overlap
+func overlapPolicyFlag(usage string) cli.Flag {
+	return &cli.StringFlag{
+		Name:  "overlap-policy",
+		Usage: usage + ": Skip, BufferOne, BufferAll, CancelOther, TerminateOther or AllowAll",
+	}
+}
+
+// scheduleFlags returns the flags that define a schedule. They are shared by
+// create and update; for update every flag is optional and only the given
+// ones are changed.
+func scheduleFlags(create bool) []cli.Flag {
+	flags := []cli.Flag{
+		scheduleIDFlag(),
+		&cli.StringSliceFlag{
+			Name:  "cron",
+			Usage: "Cron expression, e.g. \"0 9 * * MON-FRI\"; repeat for multiple expressions",
+		},
+		&cli.StringSliceFlag{
+			Name:  "interval",
+			Usage: "Interval as a duration with an optional offset, e.g. \"1h\" or \"1h/15m\"; repeat for multiple intervals",
+		},
+		&cli.StringFlag{
+			Name:  "time-zone",
+			Usage: "IANA time zone for cron expressions, e.g. \"Europe/Stockholm\" (default: UTC)",
+		},
+		&cli.DurationFlag{
+			Name:  "jitter",
+			Usage: "Random delay of up to this long added to each action",
+		},
+		&cli.StringFlag{
+			Name:     "workflow-type",
+			Aliases:  []string{"t"},
+			Usage:    "Type of workflow the schedule starts",
+			Required: create,
+		},
+		&cli.StringSliceFlag{
+			Name:    "input",
+			Aliases: []string{"i"},
+			Usage:   "JSON input for the workflow; repeat for multiple arguments (\"-\" reads from stdin)",
+			Value:   cli.NewStringSlice("{}"),
+		},
+		&cli.BoolFlag{
+			Name:  "input-args",
+			Usage: "Treat the input as a JSON array whose elements are passed as separate arguments",
+		},
+		&cli.StringFlag{
+			Name:    "workflow-id",
+			Aliases: []string{"w"},
+			Usage:   "Workflow ID prefix for started workflows; the server appends the scheduled time (default: the schedule ID)",
+		},
+		// Shadows the global flag so update can tell it was given here rather
+		// than through TEMPORAL_TASK_QUEUE or a profile
+		&cli.StringFlag{
+			Name:    "task-queue",
+			Aliases: []string{"q"},
+			Usage:   "Task queue for started workflows (default: the global --task-queue)",
+		},
+		overlapPolicyFlag("What to do when a run is due while the previous one is still running"),
+		&cli.DurationFlag{
+			Name:  "catchup-window",
+			Usage: "How far back missed actions are run after the server was unavailable",
+		},
+		&cli.BoolFlag{
+			Name:  "pause-on-failure",
+			Usage: "Pause the schedule when a started workflow fails",
+		},
+		&cli.BoolFlag{
+			Name:  "paused",
+			Usage: "Whether the schedule is paused",
+		},
+		&cli.StringFlag{
+			Name:  "note",
+			Usage: "Note describing the schedule's state",
+		},
+	}
+
+	// Schedules don't take the per-start options that overlap with their own
+	for _, flag := range startOptionFlags() {
+		switch flag.Names()[0] {
+		case "cron", "id-reuse-policy", "start-delay":
+			continue
+		}
+		flags = append(flags, flag)
+	}
+
+	return flags
+}
+
+// newScheduleCommand creates the command group for managing schedules
+func newScheduleCommand(config *TemporalConfig) *cli.Command {
+	return &cli.Command{
+		Name:  "schedule",
+		Usage: "Create and manage schedules that start workflows",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "Create a schedule",
+				Flags: scheduleFlags(true),
+				Action: func(c *cli.Context) error {
+					return createSchedule(c, *config)
+				},
+			},
+			{
+				Name:  "list",
+				Usage: "List schedules",
+				Action: func(c *cli.Context) error {
+					return listSchedules(c, *config)
+				},
+			},
+			{
+				Name:  "describe",
+				Usage: "Show a schedule's spec, action, state and recent runs",
+				Flags: []cli.Flag{scheduleIDFlag()},
+				Action: func(c *cli.Context) error {
+					return describeSchedule(c, *config)
+				},
+			},
+			{
+				Name:  "update",
+				Usage: "Change a schedule; only the given flags are updated",
+				Flags: scheduleFlags(false),
+				Action: func(c *cli.Context) error {
+					return updateSchedule(c, *config)
+				},
+			},
+			{
+				Name:  "delete",
+				Usage: "Delete a schedule; workflows it started keep running",
+				Flags: []cli.Flag{
+					scheduleIDFlag(),
+					&cli.BoolFlag{
+						Name:    "yes",
+						Aliases: []string{"y"},
+						Usage:   "Skip the confirmation prompt",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return deleteSchedule(c, *config)
+				},
+			},
+			{
+				Name:  "pause",
+				Usage: "Pause a schedule",
+				Flags: []cli.Flag{
+					scheduleIDFlag(),
+					&cli.StringFlag{
+						Name:  "note",
+						Usage: "Reason for pausing",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return pauseSchedule(c, *config, true)
+				},
+			},
+			{
+				Name:  "unpause",
+				Usage: "Unpause a schedule",
+				Flags: []cli.Flag{
+					scheduleIDFlag(),
+					&cli.StringFlag{
+						Name:  "note",
+						Usage: "Reason for unpausing",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return pauseSchedule(c, *config, false)
+				},
+			},
+			{
+				Name:  "trigger",
+				Usage: "Run a schedule's action immediately",
+				Flags: []cli.Flag{
+					scheduleIDFlag(),
+					overlapPolicyFlag("Overlap policy for this run (default: the schedule's policy)"),
+				},
+				Action: func(c *cli.Context) error {
+					return triggerSchedule(c, *config)
+				},
+			},
+			{
+				Name:  "backfill",
+				Usage: "Run the actions a schedule would have taken during a past time range",
+				Flags: []cli.Flag{
+					scheduleIDFlag(),
+					&cli.StringFlag{
+						Name:     "start-time",
+						Usage:    "Start of the range (RFC3339, YYYY-MM-DD, or a duration ago like 24h)",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "end-time",
+						Usage: "End of the range, in the same formats as --start-time (default: now)",
+					},
+					overlapPolicyFlag("Overlap policy for the backfilled runs (default: the schedule's policy)"),
+				},
+				Action: func(c *cli.Context) error {
+					return backfillSchedule(c, *config)
+				},
+			},
+		},
+	}
+}
+
+// parseOverlapPolicy parses the --overlap-policy flag, returning unspecified if it is not set
+func parseOverlapPolicy(c *cli.Context) (enums.ScheduleOverlapPolicy, error) {
+	name := c.String("overlap-policy")
+	if name == "" {
+		return enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, nil
+	}
+	_, value, ok := lookupEnumName(enums.ScheduleOverlapPolicy_value, name)
+	if !ok || value == int32(enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED) {
+		return enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, fmt.Errorf("unknown overlap policy '%s'", name)
+	}
+	return enums.ScheduleOverlapPolicy(value), nil
+}
+
+// parseInterval parses an interval of the form "every" or "every/offset"
+func parseInterval(value string) (client.ScheduleIntervalSpec, error) {
+	everyText, offsetText, hasOffset := strings.Cut(value, "/")
+
+	every, err := time.ParseDuration(strings.TrimSpace(everyText))
+	if err != nil || every <= 0 {
+		return client.ScheduleIntervalSpec{}, fmt.Errorf("invalid interval '%s': expected a duration like 1h or 1h/15m", value)
+	}
+
+	interval := client.ScheduleIntervalSpec{Every: every}
+	if hasOffset {
+		offset, err := time.ParseDuration(strings.TrimSpace(offsetText))
+		if err != nil || offset < 0 {
+			return client.ScheduleIntervalSpec{}, fmt.Errorf("invalid interval offset in '%s'", value)
+		}
+		interval.Offset = offset
+	}
+	return interval, nil
+}
+
+// parseIntervals parses each value of the --interval flag
+func parseIntervals(values []string) ([]client.ScheduleIntervalSpec, error) {
+	var intervals []client.ScheduleIntervalSpec
+	for _, value := range values {
+		interval, err := parseInterval(value)
+		if err != nil {
+			return nil, err
+		}
+		intervals = append(intervals, interval)
+	}
+	return intervals, nil
+}
+
+// buildScheduleSpec creates a schedule spec from the --cron and --interval flags
+func buildScheduleSpec(c *cli.Context) (client.ScheduleSpec, error) {
+	spec := client.ScheduleSpec{
+		CronExpressions: c.StringSlice("cron"),
+		TimeZoneName:    c.String("time-zone"),
+		Jitter:          c.Duration("jitter"),
+	}
+
+	intervals, err := parseIntervals(c.StringSlice("interval"))
+	if err != nil {
+		return spec, err
+	}
+	spec.Intervals = intervals
+
+	if len(spec.CronExpressions) == 0 && len(spec.Intervals) == 0 {
+		return spec, fmt.Errorf("at least one --cron or --interval is required")
+	}
+	return spec, nil
+}
+
+// buildScheduleAction creates the workflow action from the same input and
+// start options the start command uses
+func buildScheduleAction(c *cli.Context, config TemporalConfig) (*client.ScheduleWorkflowAction, error) {
+	args, err := parseInputArgs(config, c.StringSlice("input"), c.Bool("input-args"), "input")
+	if err != nil {
+		return nil, err
+	}
+
+	workflowID := c.String("workflow-id")
+	if workflowID == "" {
+		workflowID = c.String("schedule-id")
+	}
+
+	options, err := buildStartWorkflowOptions(c, config, workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.IsSet("task-queue") {
+		options.TaskQueue = c.String("task-queue")
+	}
+
+	return &client.ScheduleWorkflowAction{
+		ID:                       options.ID,
+		Workflow:                 c.String("workflow-type"),
+		Args:                     args,
+		TaskQueue:                options.TaskQueue,
+		WorkflowExecutionTimeout: options.WorkflowExecutionTimeout,
+		WorkflowRunTimeout:       options.WorkflowRunTimeout,
+		WorkflowTaskTimeout:      options.WorkflowTaskTimeout,
+		RetryPolicy:              options.RetryPolicy,
+		Memo:                     options.Memo,
+		SearchAttributes:         options.SearchAttributes,
+	}, nil
+}
+
+// createSchedule creates a schedule that starts a workflow
+func createSchedule(c *cli.Context, config TemporalConfig) error {
+	spec, err := buildScheduleSpec(c)
+	if err != nil {
+		return err
+	}
+
+	action, err := buildScheduleAction(c, config)
+	if err != nil {
+		return err
+	}
+
+	overlap, err := parseOverlapPolicy(c)
+	if err != nil {
+		return err
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	handle, err := temporalClient.ScheduleClient().Create(ctx, client.ScheduleOptions{
+		ID:             c.String("schedule-id"),
+		Spec:           spec,
+		Action:         action,
+		Overlap:        overlap,
+		CatchupWindow:  c.Duration("catchup-window"),
+		PauseOnFailure: c.Bool("pause-on-failure"),
+		Paused:         c.Bool("paused"),
+		Note:           c.String("note"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, scheduleRef{ID: handle.GetID()})
+	}
+
+	fmt.Printf("%sCreated schedule %s%s\n", colorGreen, handle.GetID(), colorReset)
+	return nil
+}
+
+// listSchedules prints every schedule in the namespace
+func listSchedules(c *cli.Context, config TemporalConfig) error {
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout, allowing for several pages of results
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	iter, err := temporalClient.ScheduleClient().List(ctx, client.ScheduleListOptions{PageSize: 100})
+	if err != nil {
+		return fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	var schedules []scheduleSummary
+	for iter.HasNext() {
+		entry, err := iter.Next()
+		if err != nil {
+			return fmt.Errorf("failed to list schedules: %w", err)
+		}
+
+		summary := scheduleSummary{
+			ID:           entry.ID,
+			WorkflowType: entry.WorkflowType.Name,
+			Paused:       entry.Paused,
+			Note:         entry.Note,
+			Spec:         newScheduleSpecSummary(entry.Spec),
+		}
+		if len(entry.NextActionTimes) > 0 {
+			summary.NextRunTime = &entry.NextActionTimes[0]
+		}
+		if n := len(entry.RecentActions); n > 0 {
+			summary.LastRunTime = &entry.RecentActions[n-1].ActualTime
+		}
+		schedules = append(schedules, summary)
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, schedules)
+	}
+
+	fmt.Printf("Found %d schedules:\n", len(schedules))
+	for i, schedule := range schedules {
+		state := fmt.Sprintf("%sactive%s", colorGreen, colorReset)
+		if schedule.Paused {
+			state = fmt.Sprintf("%spaused%s", colorYellow, colorReset)
+		}
+		fmt.Printf("%d. ID: %s, Workflow Type: %s, State: %s, Spec: %s",
+			i+1, schedule.ID, schedule.WorkflowType, state, strings.Join(schedule.Spec.lines(), "; "))
+		if schedule.NextRunTime != nil {
+			fmt.Printf(", Next Run: %v", schedule.NextRunTime.Local().Format(time.RFC3339))
+		}
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// describeSchedule prints the full definition and state of a schedule
+func describeSchedule(c *cli.Context, config TemporalConfig) error {
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	handle := temporalClient.ScheduleClient().GetHandle(ctx, c.String("schedule-id"))
+	desc, err := handle.Describe(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to describe schedule: %w", err)
+	}
+
+	description := newScheduleDescription(handle.GetID(), desc)
+	if isStructuredOutput(config) {
+		return printStructured(config, description)
+	}
+
+	printScheduleDescription(description)
+	return nil
+}
+
+// updateSchedule changes the parts of a schedule given on the command line
+func updateSchedule(c *cli.Context, config TemporalConfig) error {
+	intervals, err := parseIntervals(c.StringSlice("interval"))
+	if err != nil {
+		return err
+	}
+
+	overlap, err := parseOverlapPolicy(c)
+	if err != nil {
+		return err
+	}
+
+	// Only rebuild the action when a flag that shapes it was given
+	var newAction *client.ScheduleWorkflowAction
+	for _, name := range []string{"workflow-type", "input", "workflow-id", "task-queue", "execution-timeout",
+		"run-timeout", "task-timeout", "memo", "search-attribute"} {
+		if c.IsSet(name) {
+			if newAction, err = buildScheduleAction(c, config); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	retryPolicy := buildRetryPolicy(c)
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	handle := temporalClient.ScheduleClient().GetHandle(ctx, c.String("schedule-id"))
+	err = handle.Update(ctx, client.ScheduleUpdateOptions{
+		DoUpdate: func(input client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+			schedule := input.Description.Schedule
+
+			if schedule.Spec == nil {
+				schedule.Spec = &client.ScheduleSpec{}
+			}
+			mergeScheduleSpec(c, schedule.Spec, intervals)
+
+			if action, ok := schedule.Action.(*client.ScheduleWorkflowAction); ok {
+				mergeScheduleAction(c, action, newAction)
+				if retryPolicy != nil {
+					action.RetryPolicy = retryPolicy
+				}
+			}
+
+			if schedule.Policy == nil {
+				schedule.Policy = &client.SchedulePolicies{}
+			}
+			if overlap != enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+				schedule.Policy.Overlap = overlap
+			}
+			if c.IsSet("catchup-window") {
+				schedule.Policy.CatchupWindow = c.Duration("catchup-window")
+			}
+			if c.IsSet("pause-on-failure") {
+				schedule.Policy.PauseOnFailure = c.Bool("pause-on-failure")
+			}
+
+			if schedule.State == nil {
+				schedule.State = &client.ScheduleState{}
+			}
+			if c.IsSet("paused") {
+				schedule.State.Paused = c.Bool("paused")
+			}
+			if c.IsSet("note") {
+				schedule.State.Note = c.String("note")
+			}
+
+			return &client.ScheduleUpdate{Schedule: &schedule}, nil
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, scheduleRef{ID: handle.GetID()})
+	}
+
+	fmt.Printf("%sUpdated schedule %s%s\n", colorGreen, handle.GetID(), colorReset)
+	return nil
+}
+
+// mergeScheduleSpec applies the spec flags given on the command line to the
+// schedule's current spec. The server returns cron expressions as calendars,
+// so --cron replaces those too; start and end times, skips and any flag not
+// given are kept.
+func mergeScheduleSpec(c *cli.Context, spec *client.ScheduleSpec, intervals []client.ScheduleIntervalSpec) {
+	if c.IsSet("cron") {
+		spec.CronExpressions = c.StringSlice("cron")
+		spec.Calendars = nil
+	}
+	if c.IsSet("interval") {
+		spec.Intervals = intervals
+	}
+	if c.IsSet("time-zone") {
+		spec.TimeZoneName = c.String("time-zone")
+	}
+	if c.IsSet("jitter") {
+		spec.Jitter = c.Duration("jitter")
+	}
+}
+
+// mergeScheduleAction copies the action fields given on the command line onto
+// the schedule's current action, leaving the others as they were
+func mergeScheduleAction(c *cli.Context, action, update *client.ScheduleWorkflowAction) {
+	if update == nil {
+		return
+	}
+	if c.IsSet("workflow-type") {
+		action.Workflow = update.Workflow
+	}
+	if c.IsSet("input") {
+		action.Args = update.Args
+	}
+	if c.IsSet("workflow-id") {
+		action.ID = update.ID
+	}
+	if c.IsSet("task-queue") {
+		action.TaskQueue = update.TaskQueue
+	}
+	if c.IsSet("execution-timeout") {
+		action.WorkflowExecutionTimeout = update.WorkflowExecutionTimeout
+	}
+	if c.IsSet("run-timeout") {
+		action.WorkflowRunTimeout = update.WorkflowRunTimeout
+	}
+	if c.IsSet("task-timeout") {
+		action.WorkflowTaskTimeout = update.WorkflowTaskTimeout
+	}
+	if c.IsSet("memo") {
+		action.Memo = update.Memo
+	}
+	if c.IsSet("search-attribute") {
+		action.SearchAttributes = update.SearchAttributes
+	}
+}
+
+// deleteSchedule deletes a schedule after confirming with the user
+func deleteSchedule(c *cli.Context, config TemporalConfig) error {
+	scheduleID := c.String("schedule-id")
+	if !c.Bool("yes") && !confirmAction(fmt.Sprintf("Delete schedule %s", scheduleID)) {
+		return fmt.Errorf("delete aborted by user")
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := temporalClient.ScheduleClient().GetHandle(ctx, scheduleID).Delete(ctx); err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, scheduleRef{ID: scheduleID})
+	}
+
+	fmt.Printf("%sDeleted schedule %s%s\n", colorGreen, scheduleID, colorReset)
+	return nil
+}
+
+// pauseSchedule pauses or unpauses a schedule
+func pauseSchedule(c *cli.Context, config TemporalConfig, pause bool) error {
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	scheduleID := c.String("schedule-id")
+	handle := temporalClient.ScheduleClient().GetHandle(ctx, scheduleID)
+	if pause {
+		err = handle.Pause(ctx, client.SchedulePauseOptions{Note: c.String("note")})
+	} else {
+		err = handle.Unpause(ctx, client.ScheduleUnpauseOptions{Note: c.String("note")})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update schedule state: %w", err)
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, scheduleStateResult{ID: scheduleID, Paused: pause, Note: c.String("note")})
+	}
+
+	if pause {
+		fmt.Printf("%sPaused schedule %s%s\n", colorYellow, scheduleID, colorReset)
+	} else {
+		fmt.Printf("%sUnpaused schedule %s%s\n", colorGreen, scheduleID, colorReset)
+	}
+	return nil
+}
+
+// triggerSchedule runs a schedule's action immediately
+func triggerSchedule(c *cli.Context, config TemporalConfig) error {
+	overlap, err := parseOverlapPolicy(c)
+	if err != nil {
+		return err
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	scheduleID := c.String("schedule-id")
+	handle := temporalClient.ScheduleClient().GetHandle(ctx, scheduleID)
+	if err := handle.Trigger(ctx, client.ScheduleTriggerOptions{Overlap: overlap}); err != nil {
+		return fmt.Errorf("failed to trigger schedule: %w", err)
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, scheduleRef{ID: scheduleID})
+	}
+
+	fmt.Printf("%sTriggered schedule %s%s\n", colorGreen, scheduleID, colorReset)
+	return nil
+}
+
+// backfillSchedule runs the actions a schedule would have taken in a past time range
+func backfillSchedule(c *cli.Context, config TemporalConfig) error {
+	start, err := parseTimeFlag(c.String("start-time"))
+	if err != nil {
+		return fmt.Errorf("invalid --start-time: %w", err)
+	}
+	end := time.Now()
+	if c.IsSet("end-time") {
+		if end, err = parseTimeFlag(c.String("end-time")); err != nil {
+			return fmt.Errorf("invalid --end-time: %w", err)
+		}
+	}
+	if !end.After(start) {
+		return fmt.Errorf("--end-time must be after --start-time")
+	}
+
+	overlap, err := parseOverlapPolicy(c)
+	if err != nil {
+		return err
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	scheduleID := c.String("schedule-id")
+	handle := temporalClient.ScheduleClient().GetHandle(ctx, scheduleID)
+	err = handle.Backfill(ctx, client.ScheduleBackfillOptions{
+		Backfill: []client.ScheduleBackfill{{Start: start, End: end, Overlap: overlap}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to backfill schedule: %w", err)
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, scheduleBackfillResult{ID: scheduleID, StartTime: start, EndTime: end})
+	}
+
+	fmt.Printf("%sBackfilling schedule %s from %s to %s%s\n", colorGreen, scheduleID,
+		start.Format(time.RFC3339), end.Format(time.RFC3339), colorReset)
+	return nil
+}
+
+// formatCalendarSpec renders a calendar spec as field=ranges pairs, e.g. "hour=9 dayOfWeek=1-5"
+func formatCalendarSpec(calendar client.ScheduleCalendarSpec) string {
+	fields := []struct {
+		name   string
+		ranges []client.ScheduleRange
+	}{
+		{"second", calendar.Second},
+		{"minute", calendar.Minute},
+		{"hour", calendar.Hour},
+		{"dayOfMonth", calendar.DayOfMonth},
+		{"month", calendar.Month},
+		{"year", calendar.Year},
+		{"dayOfWeek", calendar.DayOfWeek},
+	}
+
+	var parts []string
+	for _, field := range fields {
+		if len(field.ranges) == 0 {
+			continue
+		}
+		values := make([]string, 0, len(field.ranges))
+		for _, r := range field.ranges {
+			value := fmt.Sprint(r.Start)
+			if r.End > r.Start {
+				value += fmt.Sprintf("-%d", r.End)
+			}
+			if r.Step > 1 {
+				value += fmt.Sprintf("/%d", r.Step)
+			}
+			values = append(values, value)
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", field.name, strings.Join(values, ",")))
+	}
+
+	if calendar.Comment != "" {
+		parts = append(parts, fmt.Sprintf("(%s)", calendar.Comment))
+	}
+	return strings.Join(parts, " ")
+}
+
+// newScheduleSpecSummary describes when a schedule runs
+func newScheduleSpecSummary(spec *client.ScheduleSpec) scheduleSpecSummary {
+	var summary scheduleSpecSummary
+	if spec == nil {
+		return summary
+	}
+
+	summary.Cron = spec.CronExpressions
+	for _, interval := range spec.Intervals {
+		value := interval.Every.String()
+		if interval.Offset > 0 {
+			value += "/" + interval.Offset.String()
+		}
+		summary.Intervals = append(summary.Intervals, value)
+	}
+	for _, calendar := range spec.Calendars {
+		summary.Calendars = append(summary.Calendars, formatCalendarSpec(calendar))
+	}
+
+	summary.TimeZone = spec.TimeZoneName
+	summary.Jitter = formatDuration(spec.Jitter)
+	if !spec.StartAt.IsZero() {
+		summary.StartAt = &spec.StartAt
+	}
+	if !spec.EndAt.IsZero() {
+		summary.EndAt = &spec.EndAt
+	}
+	return summary
+}
+
+// lines renders the spec as one human-readable line per rule
+func (s scheduleSpecSummary) lines() []string {
+	var lines []string
+	for _, cron := range s.Cron {
+		lines = append(lines, "cron "+cron)
+	}
+	for _, interval := range s.Intervals {
+		lines = append(lines, "every "+interval)
+	}
+	for _, calendar := range s.Calendars {
+		lines = append(lines, "calendar "+calendar)
+	}
+	return lines
+}
+
+// scheduleArgValue decodes a described action argument. Described schedules
+// return their arguments as raw payloads.
+func scheduleArgValue(arg interface{}) interface{} {
+	if payload, ok := arg.(*common.Payload); ok {
+		return decodePayload(payload)
+	}
+	return arg
+}
+
+// newScheduleDescription builds the structured form of a described schedule
+func newScheduleDescription(id string, desc *client.ScheduleDescription) scheduleDescription {
+	schedule := desc.Schedule
+	description := scheduleDescription{
+		ID:              id,
+		Spec:            newScheduleSpecSummary(schedule.Spec),
+		NumActions:      desc.Info.NumActions,
+		NextActionTimes: desc.Info.NextActionTimes,
+		CreatedAt:       desc.Info.CreatedAt,
+	}
+	if !desc.Info.LastUpdateAt.IsZero() {
+		description.LastUpdateAt = &desc.Info.LastUpdateAt
+	}
+
+	if action, ok := schedule.Action.(*client.ScheduleWorkflowAction); ok {
+		summary := &scheduleActionSummary{
+			WorkflowID:       action.ID,
+			WorkflowType:     fmt.Sprint(action.Workflow),
+			TaskQueue:        action.TaskQueue,
+			ExecutionTimeout: formatDuration(action.WorkflowExecutionTimeout),
+			RunTimeout:       formatDuration(action.WorkflowRunTimeout),
+			TaskTimeout:      formatDuration(action.WorkflowTaskTimeout),
+		}
+		for _, arg := range action.Args {
+			summary.Input = append(summary.Input, scheduleArgValue(arg))
+		}
+		description.Action = summary
+	}
+
+	if policy := schedule.Policy; policy != nil {
+		if policy.Overlap != enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+			description.Overlap = enums.ScheduleOverlapPolicy_name[int32(policy.Overlap)]
+		}
+		description.CatchupWindow = formatDuration(policy.CatchupWindow)
+		description.PauseOnFailure = policy.PauseOnFailure
+	}
+
+	if state := schedule.State; state != nil {
+		description.Paused = state.Paused
+		description.Note = state.Note
+		if state.LimitedActions {
+			remaining := state.RemainingActions
+			description.RemainingActions = &remaining
+		}
+	}
+
+	for _, running := range desc.Info.RunningWorkflows {
+		description.RunningWorkflows = append(description.RunningWorkflows, scheduleRun{
+			WorkflowID: running.WorkflowID,
+			RunID:      running.FirstExecutionRunID,
+		})
+	}
+	for _, action := range desc.Info.RecentActions {
+		run := scheduleRun{ScheduleTime: action.ScheduleTime, ActualTime: action.ActualTime}
+		if result := action.StartWorkflowResult; result != nil {
+			run.WorkflowID = result.WorkflowID
+			run.RunID = result.FirstExecutionRunID
+		}
+		description.RecentActions = append(description.RecentActions, run)
+	}
+
+	return description
+}
+
+// printScheduleDescription prints a described schedule
+func printScheduleDescription(d scheduleDescription) {
+	fmt.Printf("%s%s==== Schedule: %s ====%s\n", colorBold, colorBlue, d.ID, colorReset)
+	if d.Paused {
+		fmt.Printf("State: %spaused%s\n", colorYellow, colorReset)
+	} else {
+		fmt.Printf("State: %sactive%s\n", colorGreen, colorReset)
+	}
+	if d.Note != "" {
+		fmt.Printf("Note: %s\n", d.Note)
+	}
+	if d.RemainingActions != nil {
+		fmt.Printf("Remaining Actions: %d\n", *d.RemainingActions)
+	}
+
+	fmt.Printf("\n%sSpec:%s\n", colorBold, colorReset)
+	for _, line := range d.Spec.lines() {
+		fmt.Printf("  %s\n", line)
+	}
+	if d.Spec.TimeZone != "" {
+		fmt.Printf("  Time Zone: %s\n", d.Spec.TimeZone)
+	}
+	if d.Spec.Jitter != "" {
+		fmt.Printf("  Jitter: %s\n", d.Spec.Jitter)
+	}
+
+	if action := d.Action; action != nil {
+		fmt.Printf("\n%sAction:%s\n", colorBold, colorReset)
+		fmt.Printf("  Workflow Type: %s\n", action.WorkflowType)
+		fmt.Printf("  Workflow ID: %s\n", action.WorkflowID)
+		fmt.Printf("  Task Queue: %s\n", action.TaskQueue)
+		if action.ExecutionTimeout != "" {
+			fmt.Printf("  Execution Timeout: %s\n", action.ExecutionTimeout)
+		}
+		if action.RunTimeout != "" {
+			fmt.Printf("  Run Timeout: %s\n", action.RunTimeout)
+		}
+		if len(action.Input) > 0 {
+			fmt.Printf("  Input:\n%s\n", formatJSON(action.Input))
+		}
+	}
+
+	fmt.Printf("\n%sPolicy:%s\n", colorBold, colorReset)
+	if d.Overlap != "" {
+		fmt.Printf("  Overlap: %s\n", d.Overlap)
+	}
+	if d.CatchupWindow != "" {
+		fmt.Printf("  Catchup Window: %s\n", d.CatchupWindow)
+	}
+	fmt.Printf("  Pause On Failure: %v\n", d.PauseOnFailure)
+
+	fmt.Printf("\n%sActivity:%s\n", colorBold, colorReset)
+	fmt.Printf("  Actions Taken: %d\n", d.NumActions)
+	for _, running := range d.RunningWorkflows {
+		fmt.Printf("  Running: %s (run %s)\n", running.WorkflowID, running.RunID)
+	}
+	for _, action := range d.RecentActions {
+		fmt.Printf("  Recent: %s -> %s\n", action.ActualTime.Local().Format(time.RFC3339), action.WorkflowID)
+	}
+	for _, next := range d.NextActionTimes {
+		fmt.Printf("  Next: %s\n", next.Local().Format(time.RFC3339))
+	}
+}
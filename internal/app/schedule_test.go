@@ -0,0 +1,51 @@
+package app
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/sdk/client"
+)
+
+func TestMergeScheduleSpec(t *testing.T) {
+	set := flag.NewFlagSet("update", flag.ContinueOnError)
+	for _, f := range scheduleFlags(false) {
+		if err := f.Apply(set); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := set.Parse([]string{"--schedule-id", "daily-report", "--interval", "2h"}); err != nil {
+		t.Fatal(err)
+	}
+	c := cli.NewContext(cli.NewApp(), set, nil)
+
+	startAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	calendars := []client.ScheduleCalendarSpec{{Hour: []client.ScheduleRange{{Start: 9}}}}
+	spec := client.ScheduleSpec{
+		Calendars:    calendars,
+		Intervals:    []client.ScheduleIntervalSpec{{Every: time.Hour}},
+		StartAt:      startAt,
+		Jitter:       time.Minute,
+		TimeZoneName: "Europe/Stockholm",
+	}
+
+	intervals, err := parseIntervals(c.StringSlice("interval"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mergeScheduleSpec(c, &spec, intervals)
+
+	want := client.ScheduleSpec{
+		Calendars:    calendars,
+		Intervals:    []client.ScheduleIntervalSpec{{Every: 2 * time.Hour}},
+		StartAt:      startAt,
+		Jitter:       time.Minute,
+		TimeZoneName: "Europe/Stockholm",
+	}
+	if !reflect.DeepEqual(spec, want) {
+		t.Errorf("spec = %+v, want %+v", spec, want)
+	}
+}
@@ -0,0 +1,406 @@
+package app
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// jsonSchemaDraft is the JSON Schema version of inferred schemas
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// jsonTypeOrder is the order types are listed in when a value has several
+var jsonTypeOrder = []string{"object", "array", "string", "integer", "number", "boolean", "null"}
+
+// enumMaxValues is the most distinct values a string field can have to be
+// inferred as an enum. Each value must also have been seen twice on average,
+// so that fields like IDs whose values never repeat aren't mistaken for one.
+const enumMaxValues = 8
+
+// stringFormats are the formats detected in string values, in the order they
+// are tried
+var stringFormats = []struct {
+	name    string
+	matches func(string) bool
+}{
+	{"date-time", func(s string) bool {
+		_, err := time.Parse(time.RFC3339Nano, s)
+		return err == nil
+	}},
+	{"date", func(s string) bool {
+		_, err := time.Parse("2006-01-02", s)
+		return err == nil
+	}},
+	{"uuid", regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`).MatchString},
+	{"email", regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`).MatchString},
+	{"uri", func(s string) bool {
+		u, err := url.Parse(s)
+		return err == nil && u.Scheme != "" && u.Host != ""
+	}},
+	{"duration", func(s string) bool {
+		// The pattern's parts are all optional, so also reject "P" and a trailing "T"
+		return isoDuration.MatchString(s) && s != "P" && !strings.HasSuffix(s, "T")
+	}},
+}
+
+// isoDuration matches an ISO 8601 duration such as P3DT4H or PT30M
+var isoDuration = regexp.MustCompile(`^P(\d+(\.\d+)?Y)?(\d+(\.\d+)?M)?(\d+(\.\d+)?W)?(\d+(\.\d+)?D)?(T(\d+(\.\d+)?H)?(\d+(\.\d+)?M)?(\d+(\.\d+)?S)?)?$`)
+
+// detectStringFormat returns the format of a string value, or "" if it has none
+func detectStringFormat(s string) string {
+	for _, format := range stringFormats {
+		if format.matches(s) {
+			return format.name
+		}
+	}
+	return ""
+}
+
+// matchesStringFormat reports whether a string has the given format. Unknown
+// formats match anything.
+func matchesStringFormat(format, s string) bool {
+	for _, f := range stringFormats {
+		if f.name == format {
+			return f.matches(s)
+		}
+	}
+	return true
+}
+
+// schemaNode accumulates every value seen at one position of the samples, so
+// that a single schema can describe all of them
+type schemaNode struct {
+	types map[string]bool
+	// objects counts the samples that were objects, and present how many of
+	// those had a non-null value for each property
+	objects    int
+	properties map[string]*schemaNode
+	present    map[string]int
+	// items merges every element of every array seen here
+	items *schemaNode
+	// stringCount counts the string values seen, and values how often each
+	// distinct one was seen until there are too many for an enum
+	stringCount int
+	values      map[string]int
+	// format is the format shared by every non-empty string, "" if none has
+	// been seen yet, or mixedFormat if they differ
+	format string
+}
+
+// mixedFormat marks string values that don't share a format
+const mixedFormat = "-"
+
+// newSchemaNode creates an empty schemaNode
+func newSchemaNode() *schemaNode {
+	return &schemaNode{
+		types:      make(map[string]bool),
+		properties: make(map[string]*schemaNode),
+		present:    make(map[string]int),
+		values:     make(map[string]int),
+	}
+}
+
+// add records one sample value
+func (n *schemaNode) add(value interface{}) {
+	n.types[getJSONType(value)] = true
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		n.objects++
+		for key, val := range v {
+			property, ok := n.properties[key]
+			if !ok {
+				property = newSchemaNode()
+				n.properties[key] = property
+			}
+			property.add(val)
+
+			// A null value is how many encoders write a missing optional field
+			if val != nil {
+				n.present[key]++
+			}
+		}
+
+	case []interface{}:
+		if n.items == nil {
+			n.items = newSchemaNode()
+		}
+		for _, item := range v {
+			n.items.add(item)
+		}
+
+	case string:
+		n.stringCount++
+		if n.values != nil {
+			n.values[v]++
+			if len(n.values) > enumMaxValues {
+				n.values = nil
+			}
+		}
+
+		// Empty strings are how many encoders write an unset field, so they
+		// don't count against a format
+		if v != "" && n.format != mixedFormat {
+			// An unformatted value rules out a format as much as a differently
+			// formatted one, whichever order they are seen in
+			if format := detectStringFormat(v); format == "" {
+				n.format = mixedFormat
+			} else if n.format == "" {
+				n.format = format
+			} else if format != n.format {
+				n.format = mixedFormat
+			}
+		}
+	}
+}
+
+// schema renders the JSON schema describing every value added to the node
+func (n *schemaNode) schema() map[string]interface{} {
+	schema := make(map[string]interface{})
+
+	var types []string
+	for _, t := range jsonTypeOrder {
+		// Integers are numbers too, so only list them when every number was whole
+		if n.types[t] && !(t == "integer" && n.types["number"]) {
+			types = append(types, t)
+		}
+	}
+	switch len(types) {
+	case 0:
+		// Nothing was seen here, so any value is allowed
+	case 1:
+		schema["type"] = types[0]
+	default:
+		schema["type"] = types
+	}
+
+	if n.objects > 0 {
+		properties := make(map[string]interface{}, len(n.properties))
+		required := []string{}
+		for key, property := range n.properties {
+			properties[key] = property.schema()
+			if n.present[key] == n.objects {
+				required = append(required, key)
+			}
+		}
+		sort.Strings(required)
+
+		schema["properties"] = properties
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+	}
+
+	if n.format != "" && n.format != mixedFormat {
+		schema["format"] = n.format
+	} else if n.onlyStrings() && len(n.values) > 0 && n.stringCount >= 2*len(n.values) {
+		values := make([]string, 0, len(n.values))
+		for value := range n.values {
+			values = append(values, value)
+		}
+		sort.Strings(values)
+
+		enum := make([]interface{}, 0, len(values)+1)
+		for _, value := range values {
+			enum = append(enum, value)
+		}
+		if n.types["null"] {
+			enum = append(enum, nil)
+		}
+		schema["enum"] = enum
+	}
+
+	if n.types["array"] {
+		items := map[string]interface{}{}
+		if n.items != nil {
+			items = n.items.schema()
+		}
+		schema["items"] = items
+	}
+
+	return schema
+}
+
+// onlyStrings reports whether every non-null value seen was a string
+func (n *schemaNode) onlyStrings() bool {
+	for t := range n.types {
+		if t != "string" && t != "null" {
+			return false
+		}
+	}
+	return n.stringCount > 0
+}
+
+// generateMergedJSONSchema builds one JSON schema describing all samples.
+// Samples that are objects with no keys in common are different structures
+// rather than variations of one, so they become alternatives under oneOf.
+func generateMergedJSONSchema(samples []interface{}, title string) map[string]interface{} {
+	groups := groupSamplesByStructure(samples)
+
+	var schema map[string]interface{}
+	if len(groups) == 1 {
+		schema = mergeSamples(groups[0]).schema()
+	} else {
+		oneOf := make([]interface{}, 0, len(groups))
+		for _, group := range groups {
+			oneOf = append(oneOf, mergeSamples(group).schema())
+		}
+		schema = map[string]interface{}{"oneOf": oneOf}
+	}
+
+	schema["$schema"] = jsonSchemaDraft
+	if title != "" {
+		schema["title"] = fmt.Sprintf("%s Parameters", title)
+		schema["description"] = fmt.Sprintf("Parameter schema for %s workflow", title)
+	}
+	return schema
+}
+
+// mergeSamples adds every sample to a new schemaNode
+func mergeSamples(samples []interface{}) *schemaNode {
+	node := newSchemaNode()
+	for _, sample := range samples {
+		node.add(sample)
+	}
+	return node
+}
+
+// groupSamplesByStructure splits samples into groups that describe the same
+// structure. Objects sharing at least one key are grouped together, directly
+// or through other objects; all other values form one group whose types are
+// merged into a union.
+func groupSamplesByStructure(samples []interface{}) [][]interface{} {
+	type objectGroup struct {
+		keys    map[string]bool
+		samples []interface{}
+	}
+
+	var objectGroups []*objectGroup
+	var empty, others []interface{}
+	for _, sample := range samples {
+		object, ok := sample.(map[string]interface{})
+		if !ok {
+			others = append(others, sample)
+			continue
+		}
+		if len(object) == 0 {
+			// Empty objects fit any structure
+			empty = append(empty, object)
+			continue
+		}
+
+		// Fold every group this object overlaps with into one
+		merged := &objectGroup{keys: make(map[string]bool)}
+		var remaining []*objectGroup
+		for _, group := range objectGroups {
+			if sharesKey(group.keys, object) {
+				for key := range group.keys {
+					merged.keys[key] = true
+				}
+				merged.samples = append(merged.samples, group.samples...)
+			} else {
+				remaining = append(remaining, group)
+			}
+		}
+		for key := range object {
+			merged.keys[key] = true
+		}
+		merged.samples = append(merged.samples, object)
+		objectGroups = append(remaining, merged)
+	}
+
+	var groups [][]interface{}
+	for _, group := range objectGroups {
+		groups = append(groups, group.samples)
+	}
+	if len(empty) > 0 {
+		if len(groups) > 0 {
+			groups[0] = append(groups[0], empty...)
+		} else {
+			groups = append(groups, empty)
+		}
+	}
+	if len(others) > 0 {
+		groups = append(groups, others)
+	}
+	if len(groups) == 0 {
+		groups = append(groups, nil)
+	}
+	return groups
+}
+
+// sharesKey reports whether object has any of the keys
+func sharesKey(keys map[string]bool, object map[string]interface{}) bool {
+	for key := range object {
+		if keys[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaTypes returns the types a schema allows, whether given as a single
+// type or as a list of them
+func schemaTypes(schema map[string]interface{}) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	case []string:
+		return t
+	case []interface{}:
+		var types []string
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+// schemaRequired returns the required properties of an object schema
+func schemaRequired(schema map[string]interface{}) map[string]bool {
+	required := make(map[string]bool)
+	switch fields := schema["required"].(type) {
+	case []string:
+		for _, field := range fields {
+			required[field] = true
+		}
+	case []interface{}:
+		for _, field := range fields {
+			if s, ok := field.(string); ok {
+				required[s] = true
+			}
+		}
+	}
+	return required
+}
+
+// schemaValueType returns the single non-null type a schema allows, "mixed"
+// if it allows several, or "" if it doesn't say. nullable reports whether
+// null is allowed too.
+func schemaValueType(schema map[string]interface{}) (valueType string, nullable bool) {
+	var types []string
+	for _, t := range schemaTypes(schema) {
+		if t == "null" {
+			nullable = true
+		} else {
+			types = append(types, t)
+		}
+	}
+
+	switch {
+	case len(types) == 0:
+		return "", nullable
+	case len(types) == 1:
+		return types[0], nullable
+	case len(types) == 2 && types[0] == "integer" && types[1] == "number":
+		return "number", nullable
+	default:
+		return "mixed", nullable
+	}
+}
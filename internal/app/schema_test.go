@@ -0,0 +1,157 @@
+package app
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// decodeSamples parses JSON documents the way inferred inputs are decoded
+func decodeSamples(t *testing.T, documents ...string) []interface{} {
+	t.Helper()
+
+	samples := make([]interface{}, 0, len(documents))
+	for _, document := range documents {
+		var value interface{}
+		if err := json.Unmarshal([]byte(document), &value); err != nil {
+			t.Fatalf("invalid sample %s: %v", document, err)
+		}
+		samples = append(samples, value)
+	}
+	return samples
+}
+
+func TestGenerateMergedJSONSchemaRequired(t *testing.T) {
+	samples := decodeSamples(t,
+		`{"orderId": "a", "notes": "leave at door", "coupon": null}`,
+		`{"orderId": "b", "coupon": "SPRING"}`,
+	)
+
+	schema := generateMergedJSONSchema(samples, "ProcessOrder")
+	if schema["title"] != "ProcessOrder Parameters" {
+		t.Errorf("title = %v", schema["title"])
+	}
+
+	// Only orderId has a value in every sample
+	if required := schema["required"]; !reflect.DeepEqual(required, []string{"orderId"}) {
+		t.Errorf("required = %v, want [orderId]", required)
+	}
+
+	properties := schema["properties"].(map[string]interface{})
+	coupon := properties["coupon"].(map[string]interface{})
+	if !reflect.DeepEqual(coupon["type"], []string{"string", "null"}) {
+		t.Errorf("coupon type = %v, want [string null]", coupon["type"])
+	}
+}
+
+func TestGenerateMergedJSONSchemaArrays(t *testing.T) {
+	samples := decodeSamples(t,
+		`{"items": [{"sku": "x", "quantity": 1}, {"sku": "y", "gift": true}]}`,
+		`{"items": ["legacy-sku"]}`,
+	)
+
+	schema := generateMergedJSONSchema(samples, "")
+	items := schema["properties"].(map[string]interface{})["items"].(map[string]interface{})["items"].(map[string]interface{})
+
+	// Every element contributes, not just the first
+	if !reflect.DeepEqual(items["type"], []string{"object", "string"}) {
+		t.Errorf("item type = %v, want [object string]", items["type"])
+	}
+	properties := items["properties"].(map[string]interface{})
+	for _, name := range []string{"sku", "quantity", "gift"} {
+		if _, ok := properties[name]; !ok {
+			t.Errorf("item properties are missing %s", name)
+		}
+	}
+	if !reflect.DeepEqual(items["required"], []string{"sku"}) {
+		t.Errorf("item required = %v, want [sku]", items["required"])
+	}
+}
+
+func TestGenerateMergedJSONSchemaOneOf(t *testing.T) {
+	// Objects sharing a key are one structure
+	schema := generateMergedJSONSchema(decodeSamples(t, `{"a": 1, "b": 2}`, `{"b": 3, "c": 4}`, `{}`), "")
+	if _, ok := schema["oneOf"]; ok {
+		t.Errorf("overlapping objects produced oneOf: %v", schema)
+	}
+
+	// Objects with no keys in common are different structures
+	schema = generateMergedJSONSchema(decodeSamples(t, `{"a": 1}`, `{"c": 4}`), "")
+	oneOf, ok := schema["oneOf"].([]interface{})
+	if !ok || len(oneOf) != 2 {
+		t.Fatalf("oneOf = %v, want 2 alternatives", schema["oneOf"])
+	}
+	if schema["$schema"] != jsonSchemaDraft {
+		t.Errorf("$schema = %v", schema["$schema"])
+	}
+}
+
+func TestGenerateMergedJSONSchemaHints(t *testing.T) {
+	samples := decodeSamples(t,
+		`{"id": "3f2b8c1e-4d5a-4b6c-8d7e-9f0a1b2c3d4e", "at": "2024-05-01T12:00:00Z", "priority": "high", "quantity": 2, "price": 9.5, "timeout": "PT30M"}`,
+		`{"id": "7a1c2e3d-5b6f-4a8c-9d0e-1f2a3b4c5d6e", "at": "2024-05-02T08:30:00.123Z", "priority": "low", "quantity": 3, "price": 10, "timeout": ""}`,
+		`{"id": "0b9c8d7e-6f5a-4b3c-2d1e-0f9a8b7c6d5e", "at": "2024-05-03T00:00:00+02:00", "priority": "high", "quantity": 1, "price": 4, "timeout": "P1D"}`,
+		`{"id": "1c2d3e4f-5a6b-4c7d-8e9f-0a1b2c3d4e5f", "at": "2024-05-04T10:00:00Z", "priority": "low", "quantity": 7, "price": 1, "timeout": "PT1H"}`,
+	)
+
+	properties := generateMergedJSONSchema(samples, "")["properties"].(map[string]interface{})
+	field := func(name string) map[string]interface{} {
+		return properties[name].(map[string]interface{})
+	}
+
+	for name, format := range map[string]string{"id": "uuid", "at": "date-time", "timeout": "duration"} {
+		if got := field(name)["format"]; got != format {
+			t.Errorf("%s format = %v, want %s", name, got, format)
+		}
+	}
+	if _, ok := field("id")["enum"]; ok {
+		t.Errorf("id has an enum although no value repeats")
+	}
+
+	if enum := field("priority")["enum"]; !reflect.DeepEqual(enum, []interface{}{"high", "low"}) {
+		t.Errorf("priority enum = %v, want [high low]", enum)
+	}
+
+	if got := field("quantity")["type"]; got != "integer" {
+		t.Errorf("quantity type = %v, want integer", got)
+	}
+	if got := field("price")["type"]; got != "number" {
+		t.Errorf("price type = %v, want number", got)
+	}
+}
+
+func TestGenerateMergedJSONSchemaFormatOrder(t *testing.T) {
+	// An unformatted value rules out a format whether it comes first or last
+	for _, samples := range [][]interface{}{
+		decodeSamples(t, `{"due": "rush"}`, `{"due": "2024-05-01"}`),
+		decodeSamples(t, `{"due": "2024-05-01"}`, `{"due": "rush"}`),
+	} {
+		due := generateMergedJSONSchema(samples, "")["properties"].(map[string]interface{})["due"].(map[string]interface{})
+		if format, ok := due["format"]; ok {
+			t.Errorf("due format = %v for %v, want none", format, samples)
+		}
+	}
+}
+
+func TestDetectStringFormat(t *testing.T) {
+	for value, want := range map[string]string{
+		"2024-05-01":                 "date",
+		"ops@example.com":            "email",
+		"https://example.com/orders": "uri",
+		"P3Y6M4DT12H30M5S":           "duration",
+		"P":                          "",
+		"PT":                         "",
+		"rush":                       "",
+	} {
+		if got := detectStringFormat(value); got != want {
+			t.Errorf("detectStringFormat(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestGetStructureJSONNumbers(t *testing.T) {
+	samples := decodeSamples(t, `{"price": 10}`, `{"price": 9.5}`)
+	if a, b := getStructureJSON(samples[0]), getStructureJSON(samples[1]); !reflect.DeepEqual(a, b) {
+		t.Errorf("whole and fractional prices have different structures: %v and %v", a, b)
+	}
+}
@@ -0,0 +1,253 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+)
+
+// searchAttributeSummary is the structured form of a registered search attribute
+type searchAttributeSummary struct {
+	Name   string `json:"name" yaml:"name"`
+	Type   string `json:"type" yaml:"type"`
+	System bool   `json:"system" yaml:"system"`
+}
+
+// newSearchAttributeCommand creates the command group for managing custom search attributes
+func newSearchAttributeCommand(config *TemporalConfig) *cli.Command {
+	return &cli.Command{
+		Name:  "search-attribute",
+		Usage: "List, add and remove custom search attributes",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "list",
+				Usage: "List the search attributes registered in the namespace",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:    "all",
+						Aliases: []string{"a"},
+						Usage:   "Include system search attributes",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return listSearchAttributes(c, *config)
+				},
+			},
+			{
+				Name:  "add",
+				Usage: "Add custom search attributes",
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{
+						Name:     "name",
+						Aliases:  []string{"n"},
+						Usage:    "Name of the search attribute; repeat to add several",
+						Required: true,
+					},
+					&cli.StringSliceFlag{
+						Name:     "type",
+						Aliases:  []string{"t"},
+						Usage:    "Type of each search attribute: Text, Keyword, Int, Double, Bool, Datetime or KeywordList",
+						Required: true,
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return addSearchAttributes(c, *config)
+				},
+			},
+			{
+				Name:  "remove",
+				Usage: "Remove custom search attributes",
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{
+						Name:     "name",
+						Aliases:  []string{"n"},
+						Usage:    "Name of the search attribute; repeat to remove several",
+						Required: true,
+					},
+					&cli.BoolFlag{
+						Name:    "yes",
+						Aliases: []string{"y"},
+						Usage:   "Skip the confirmation prompt",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return removeSearchAttributes(c, *config)
+				},
+			},
+		},
+	}
+}
+
+// listSearchAttributes prints the custom, and optionally system, search attributes
+func listSearchAttributes(c *cli.Context, config TemporalConfig) error {
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := temporalClient.OperatorService().ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
+		Namespace: config.Namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list search attributes: %w", err)
+	}
+
+	attributes := searchAttributeSummaries(resp.GetCustomAttributes(), false)
+	if c.Bool("all") {
+		attributes = append(attributes, searchAttributeSummaries(resp.GetSystemAttributes(), true)...)
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, attributes)
+	}
+
+	if len(attributes) == 0 {
+		fmt.Println("No custom search attributes")
+		return nil
+	}
+
+	fmt.Printf("Found %d search attributes:\n", len(attributes))
+	for i, attr := range attributes {
+		kind := "custom"
+		if attr.System {
+			kind = "system"
+		}
+		fmt.Printf("%d. %s%s%s: %s (%s)\n", i+1, colorBold, attr.Name, colorReset, attr.Type, kind)
+	}
+	return nil
+}
+
+// searchAttributeSummaries converts a name to type map into summaries sorted by name
+func searchAttributeSummaries(attrs map[string]enums.IndexedValueType, system bool) []searchAttributeSummary {
+	summaries := make([]searchAttributeSummary, 0, len(attrs))
+	for name, valueType := range attrs {
+		summaries = append(summaries, searchAttributeSummary{
+			Name:   name,
+			Type:   enums.IndexedValueType_name[int32(valueType)],
+			System: system,
+		})
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Name < summaries[j].Name
+	})
+	return summaries
+}
+
+// addSearchAttributes registers new custom search attributes, pairing each
+// --name with the --type at the same position
+func addSearchAttributes(c *cli.Context, config TemporalConfig) error {
+	names := c.StringSlice("name")
+	types := c.StringSlice("type")
+	if len(names) != len(types) {
+		return fmt.Errorf("got %d names but %d types; give one --type per --name", len(names), len(types))
+	}
+
+	attrs := make(map[string]enums.IndexedValueType, len(names))
+	for i, name := range names {
+		_, valueType, ok := lookupEnumName(enums.IndexedValueType_value, types[i])
+		if !ok || valueType == int32(enums.INDEXED_VALUE_TYPE_UNSPECIFIED) {
+			return fmt.Errorf("unknown search attribute type '%s' (valid types: Text, Keyword, Int, Double, Bool, Datetime, KeywordList)", types[i])
+		}
+		attrs[name] = enums.IndexedValueType(valueType)
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Adding attributes can update the visibility store schema, which takes a while
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err = temporalClient.OperatorService().AddSearchAttributes(ctx, &operatorservice.AddSearchAttributesRequest{
+		SearchAttributes: attrs,
+		Namespace:        config.Namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add search attributes: %w", err)
+	}
+
+	for _, attr := range searchAttributeSummaries(attrs, false) {
+		infof(config, "%sAdded search attribute %s%s (%s)\n", colorGreen, attr.Name, colorReset, attr.Type)
+	}
+	return nil
+}
+
+// removeSearchAttributes removes custom search attributes after confirming with the user
+func removeSearchAttributes(c *cli.Context, config TemporalConfig) error {
+	names := c.StringSlice("name")
+
+	if !c.Bool("yes") && !confirmAction(fmt.Sprintf("Remove search attributes %s from namespace %s",
+		strings.Join(names, ", "), config.Namespace)) {
+		return fmt.Errorf("removing search attributes aborted by user")
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err = temporalClient.OperatorService().RemoveSearchAttributes(ctx, &operatorservice.RemoveSearchAttributesRequest{
+		SearchAttributes: names,
+		Namespace:        config.Namespace,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove search attributes: %w", err)
+	}
+
+	for _, name := range names {
+		infof(config, "%sRemoved search attribute %s%s\n", colorGreen, name, colorReset)
+	}
+	return nil
+}
+
+// decodePayloadMap decodes the payloads of search attributes or a memo,
+// returning nil if there are none
+func decodePayloadMap(fields map[string]*common.Payload) map[string]interface{} {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	values := make(map[string]interface{}, len(fields))
+	for name, payload := range fields {
+		values[name] = decodePayload(payload)
+	}
+	return values
+}
+
+// printPayloadMap prints decoded search attributes or memo fields in name order
+func printPayloadMap(values map[string]interface{}) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := formatJSON(values[name])
+		if strings.Contains(value, "\n") {
+			fmt.Printf("%s:\n  %s\n", name, strings.ReplaceAll(value, "\n", "\n  "))
+		} else {
+			fmt.Printf("%s: %s\n", name, value)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// stackTraceQueryType is the built-in query every SDK answers with the
+// current stack of a workflow's coroutines
+const stackTraceQueryType = "__stack_trace"
+
+// coroutineHeader matches the first line of each coroutine in a Go SDK stack
+// trace, e.g. "coroutine root [blocked on selector-1.Select]:"
+var coroutineHeader = regexp.MustCompile(`^coroutine (\S+) \[(.*)\]:$`)
+
+// blockedOn matches a coroutine state such as "blocked on chan-2.Receive"
+var blockedOn = regexp.MustCompile(`^blocked on (\S+)\.(\w+)$`)
+
+// stackFrame is a single function call in a coroutine's stack
+type stackFrame struct {
+	Function string `json:"function" yaml:"function"`
+	Location string `json:"location,omitempty" yaml:"location,omitempty"`
+}
+
+// coroutineTrace is the parsed stack of one workflow coroutine
+type coroutineTrace struct {
+	Name  string `json:"name" yaml:"name"`
+	State string `json:"state" yaml:"state"`
+	// BlockedOn is the kind of object the coroutine waits on: selector,
+	// future, channel or the name of another blocking call
+	BlockedOn string       `json:"blockedOn,omitempty" yaml:"blockedOn,omitempty"`
+	Frames    []stackFrame `json:"frames" yaml:"frames"`
+	// BlockingFrame indexes the workflow code frame the coroutine is waiting
+	// in, skipping SDK internals, or is -1 if there is none
+	BlockingFrame int `json:"blockingFrame" yaml:"blockingFrame"`
+}
+
+// stackTraceResult is the structured output of the stack command
+type stackTraceResult struct {
+	WorkflowID string           `json:"workflowId" yaml:"workflowId"`
+	RunID      string           `json:"runId,omitempty" yaml:"runId,omitempty"`
+	Coroutines []coroutineTrace `json:"coroutines,omitempty" yaml:"coroutines,omitempty"`
+	// Raw holds the trace as returned when it isn't in the Go SDK format
+	Raw string `json:"raw,omitempty" yaml:"raw,omitempty"`
+}
+
+// stackWorkflow queries the stack trace of a workflow and prints where each
+// coroutine is blocked
+func stackWorkflow(c *cli.Context, config TemporalConfig) error {
+	if config.WorkflowID == "" {
+		return fmt.Errorf("workflow ID is required for getting a stack trace")
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	response, err := temporalClient.QueryWorkflow(ctx, config.WorkflowID, config.RunID, stackTraceQueryType)
+	if err != nil {
+		return fmt.Errorf("failed to query workflow stack trace: %w", err)
+	}
+
+	var trace string
+	if err := response.Get(&trace); err != nil {
+		return fmt.Errorf("failed to parse stack trace: %w", err)
+	}
+
+	result := stackTraceResult{
+		WorkflowID: config.WorkflowID,
+		RunID:      config.RunID,
+	}
+	if c.Bool("raw") {
+		result.Raw = trace
+	} else if result.Coroutines = parseStackTrace(trace); result.Coroutines == nil {
+		result.Raw = trace
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, result)
+	}
+
+	if result.Raw != "" {
+		fmt.Println(result.Raw)
+		return nil
+	}
+
+	fmt.Printf("%s%s==== Stack Trace: %s ====%s\n", colorBold, colorBlue, config.WorkflowID, colorReset)
+	for _, coroutine := range result.Coroutines {
+		printCoroutine(coroutine)
+	}
+	return nil
+}
+
+// parseStackTrace splits a Go SDK stack trace into its coroutines. It
+// returns nil if the trace isn't in that format, as with other SDKs.
+func parseStackTrace(trace string) []coroutineTrace {
+	var coroutines []coroutineTrace
+	for _, block := range strings.Split(strings.TrimSpace(trace), "\n\n") {
+		lines := strings.Split(strings.TrimSpace(block), "\n")
+		header := coroutineHeader.FindStringSubmatch(lines[0])
+		if header == nil {
+			return nil
+		}
+
+		coroutine := coroutineTrace{
+			Name:          header[1],
+			State:         header[2],
+			Frames:        []stackFrame{},
+			BlockingFrame: -1,
+		}
+		if match := blockedOn.FindStringSubmatch(coroutine.State); match != nil {
+			coroutine.BlockedOn = blockedKind(match[1], match[2])
+		}
+
+		// Frames are a function line followed by an indented file:line line
+		for i := 1; i < len(lines); i++ {
+			frame := stackFrame{Function: strings.TrimSpace(lines[i])}
+			if i+1 < len(lines) && strings.HasPrefix(lines[i+1], "\t") {
+				i++
+				frame.Location = strings.TrimSpace(lines[i])
+			}
+			if coroutine.BlockingFrame < 0 && isWorkflowFrame(frame) {
+				coroutine.BlockingFrame = len(coroutine.Frames)
+			}
+			coroutine.Frames = append(coroutine.Frames, frame)
+		}
+
+		coroutines = append(coroutines, coroutine)
+	}
+	return coroutines
+}
+
+// blockedKind names what a coroutine is blocked on from the blocking object
+// and method in its state
+func blockedKind(object, method string) string {
+	switch {
+	case method == "Select" || strings.HasPrefix(object, "selector"):
+		return "selector"
+	case method == "Get" || strings.HasPrefix(object, "future"):
+		return "future"
+	case method == "Receive" || method == "ReceiveWithTimeout" || method == "Send" || strings.HasPrefix(object, "chan"):
+		return "channel"
+	default:
+		return object + "." + method
+	}
+}
+
+// isWorkflowFrame reports whether a frame is in workflow code rather than in
+// the SDK or the Go runtime
+func isWorkflowFrame(frame stackFrame) bool {
+	function := frame.Function
+	if strings.HasPrefix(function, "created by ") {
+		return false
+	}
+	return !strings.HasPrefix(function, "go.temporal.io/sdk/") &&
+		!strings.HasPrefix(function, "runtime.") &&
+		!strings.HasPrefix(function, "reflect.")
+}
+
+// printCoroutine prints one coroutine, marking what it is blocked on and the
+// workflow code it is blocked in
+func printCoroutine(coroutine coroutineTrace) {
+	stateColor := colorGreen
+	switch coroutine.BlockedOn {
+	case "selector":
+		stateColor = colorMagenta
+	case "future":
+		stateColor = colorCyan
+	case "":
+	default:
+		stateColor = colorYellow
+	}
+
+	fmt.Printf("\n%sCoroutine %s%s %s[%s]%s\n", colorBold, coroutine.Name, colorReset, stateColor, coroutine.State, colorReset)
+	if coroutine.BlockedOn != "" && coroutine.BlockingFrame >= 0 {
+		frame := coroutine.Frames[coroutine.BlockingFrame]
+		fmt.Printf("%sWaiting on %s in %s%s\n", stateColor, blockedDescription(coroutine.BlockedOn), frame.Function, colorReset)
+		if frame.Location != "" {
+			fmt.Printf("%s  at %s%s\n", stateColor, frame.Location, colorReset)
+		}
+	}
+
+	for i, frame := range coroutine.Frames {
+		marker := "  "
+		if i == coroutine.BlockingFrame {
+			marker = stateColor + "> "
+		}
+		fmt.Printf("%s%s%s\n", marker, frame.Function, colorReset)
+		if frame.Location != "" {
+			fmt.Printf("      %s\n", frame.Location)
+		}
+	}
+}
+
+// blockedDescription describes what a coroutine is waiting for
+func blockedDescription(kind string) string {
+	switch kind {
+	case "selector":
+		return "a selector"
+	case "future":
+		return "a future"
+	case "channel":
+		return "a channel"
+	default:
+		return kind
+	}
+}
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseStackTrace(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		trace string
+		want  []coroutineTrace
+	}{
+		{
+			name:  "empty",
+			trace: "",
+			want:  nil,
+		},
+		{
+			name:  "not the Go SDK format",
+			trace: "at ProcessOrder (workflows.ts:12:5)",
+			want:  nil,
+		},
+		{
+			name: "several coroutines",
+			trace: `coroutine root [blocked on selector-1.Select]:
+go.temporal.io/sdk/internal.(*selectorImpl).Select(0xc000123)
+	/go/pkg/mod/go.temporal.io/sdk@v1.25.1/internal/internal_workflow.go:1140 +0x5a
+example.com/orders.ProcessOrder({0x1, 0x2})
+	/src/orders/workflow.go:42 +0x1b4
+
+coroutine 1 [blocked on future-3.Get]:
+go.temporal.io/sdk/internal.(*decodeFutureImpl).Get(0xc000456)
+	/go/pkg/mod/go.temporal.io/sdk@v1.25.1/internal/internal_workflow.go:1400 +0x33
+example.com/orders.ProcessOrder.func1({0x1, 0x2})
+	/src/orders/workflow.go:30 +0x8c
+created by go.temporal.io/sdk/internal.(*workflowEnvironmentInterceptor).Go
+	/go/pkg/mod/go.temporal.io/sdk@v1.25.1/internal/internal_workflow.go:600 +0x9a
+
+coroutine 2 [running]:
+created by go.temporal.io/sdk/internal.(*workflowEnvironmentInterceptor).Go
+	/go/pkg/mod/go.temporal.io/sdk@v1.25.1/internal/internal_workflow.go:600 +0x9a`,
+			want: []coroutineTrace{
+				{
+					Name:      "root",
+					State:     "blocked on selector-1.Select",
+					BlockedOn: "selector",
+					Frames: []stackFrame{
+						{"go.temporal.io/sdk/internal.(*selectorImpl).Select(0xc000123)", "/go/pkg/mod/go.temporal.io/sdk@v1.25.1/internal/internal_workflow.go:1140 +0x5a"},
+						{"example.com/orders.ProcessOrder({0x1, 0x2})", "/src/orders/workflow.go:42 +0x1b4"},
+					},
+					BlockingFrame: 1,
+				},
+				{
+					Name:      "1",
+					State:     "blocked on future-3.Get",
+					BlockedOn: "future",
+					Frames: []stackFrame{
+						{"go.temporal.io/sdk/internal.(*decodeFutureImpl).Get(0xc000456)", "/go/pkg/mod/go.temporal.io/sdk@v1.25.1/internal/internal_workflow.go:1400 +0x33"},
+						{"example.com/orders.ProcessOrder.func1({0x1, 0x2})", "/src/orders/workflow.go:30 +0x8c"},
+						{"created by go.temporal.io/sdk/internal.(*workflowEnvironmentInterceptor).Go", "/go/pkg/mod/go.temporal.io/sdk@v1.25.1/internal/internal_workflow.go:600 +0x9a"},
+					},
+					BlockingFrame: 1,
+				},
+				{
+					// A created by frame is never the workflow code it waits in
+					Name:  "2",
+					State: "running",
+					Frames: []stackFrame{
+						{"created by go.temporal.io/sdk/internal.(*workflowEnvironmentInterceptor).Go", "/go/pkg/mod/go.temporal.io/sdk@v1.25.1/internal/internal_workflow.go:600 +0x9a"},
+					},
+					BlockingFrame: -1,
+				},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if got := parseStackTrace(test.trace); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseStackTrace() =\n%+v\nwant\n%+v", got, test.want)
+			}
+		})
+	}
+}
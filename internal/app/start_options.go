@@ -0,0 +1,278 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+)
+
+// startOptionFlags returns the flags that control how a workflow is started.
+// They are shared by every command that starts workflows.
+func startOptionFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.DurationFlag{
+			Name:  "execution-timeout",
+			Usage: "Timeout for the whole workflow execution, including retries and continue-as-new",
+		},
+		&cli.DurationFlag{
+			Name:  "run-timeout",
+			Usage: "Timeout for a single workflow run",
+		},
+		&cli.DurationFlag{
+			Name:  "task-timeout",
+			Usage: "Timeout for processing a single workflow task",
+		},
+		&cli.StringFlag{
+			Name:  "id-reuse-policy",
+			Usage: "Workflow ID reuse policy: AllowDuplicate, AllowDuplicateFailedOnly, RejectDuplicate or TerminateIfRunning",
+		},
+		&cli.DurationFlag{
+			Name:  "retry-initial-interval",
+			Usage: "Delay before the first workflow retry",
+		},
+		&cli.Float64Flag{
+			Name:  "retry-backoff-coefficient",
+			Usage: "Multiplier applied to the retry interval after each attempt",
+		},
+		&cli.DurationFlag{
+			Name:  "retry-maximum-interval",
+			Usage: "Maximum delay between workflow retries",
+		},
+		&cli.IntFlag{
+			Name:  "retry-maximum-attempts",
+			Usage: "Maximum number of workflow attempts (0 for unlimited)",
+		},
+		&cli.StringSliceFlag{
+			Name:  "retry-non-retryable-error-types",
+			Usage: "Error type that should not be retried; repeat for multiple types",
+		},
+		&cli.StringFlag{
+			Name:  "cron",
+			Usage: "Cron schedule for the workflow, e.g. \"0 * * * *\"",
+		},
+		&cli.DurationFlag{
+			Name:  "start-delay",
+			Usage: "Delay before the first workflow task is dispatched",
+		},
+		&cli.StringSliceFlag{
+			Name:  "memo",
+			Usage: "Memo entry as key=value or a JSON object; repeat for multiple entries",
+		},
+		&cli.StringSliceFlag{
+			Name:  "search-attribute",
+			Usage: "Search attribute as key=value or a JSON object; repeat for multiple attributes",
+		},
+	}
+}
+
+// buildStartWorkflowOptions creates the SDK start options from the start option flags
+func buildStartWorkflowOptions(c *cli.Context, config TemporalConfig, workflowID string) (client.StartWorkflowOptions, error) {
+	options := client.StartWorkflowOptions{
+		ID:                       workflowID,
+		TaskQueue:                config.TaskQueue,
+		WorkflowExecutionTimeout: c.Duration("execution-timeout"),
+		WorkflowRunTimeout:       c.Duration("run-timeout"),
+		WorkflowTaskTimeout:      c.Duration("task-timeout"),
+		CronSchedule:             c.String("cron"),
+		StartDelay:               c.Duration("start-delay"),
+	}
+
+	if policy := c.String("id-reuse-policy"); policy != "" {
+		_, value, ok := lookupEnumName(enums.WorkflowIdReusePolicy_value, policy)
+		if !ok || value == int32(enums.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED) {
+			return options, fmt.Errorf("unknown workflow ID reuse policy '%s'", policy)
+		}
+		options.WorkflowIDReusePolicy = enums.WorkflowIdReusePolicy(value)
+	}
+
+	options.RetryPolicy = buildRetryPolicy(c)
+
+	var err error
+	if options.Memo, err = parseKeyValues(c.StringSlice("memo"), "memo"); err != nil {
+		return options, err
+	}
+	if options.SearchAttributes, err = parseKeyValues(c.StringSlice("search-attribute"), "search attribute"); err != nil {
+		return options, err
+	}
+
+	return options, nil
+}
+
+// buildRetryPolicy returns a retry policy from the retry flags, or nil if none were given
+// so that the server defaults apply
+func buildRetryPolicy(c *cli.Context) *temporal.RetryPolicy {
+	set := false
+	for _, name := range []string{
+		"retry-initial-interval",
+		"retry-backoff-coefficient",
+		"retry-maximum-interval",
+		"retry-maximum-attempts",
+		"retry-non-retryable-error-types",
+	} {
+		if c.IsSet(name) {
+			set = true
+			break
+		}
+	}
+	if !set {
+		return nil
+	}
+
+	return &temporal.RetryPolicy{
+		InitialInterval:        c.Duration("retry-initial-interval"),
+		BackoffCoefficient:     c.Float64("retry-backoff-coefficient"),
+		MaximumInterval:        c.Duration("retry-maximum-interval"),
+		MaximumAttempts:        int32(c.Int("retry-maximum-attempts")),
+		NonRetryableErrorTypes: c.StringSlice("retry-non-retryable-error-types"),
+	}
+}
+
+// parseKeyValues parses key=value pairs and JSON objects into a single map.
+// Values are decoded as JSON where possible and kept as strings otherwise.
+func parseKeyValues(entries []string, description string) (map[string]interface{}, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]interface{})
+	for _, entry := range entries {
+		if strings.HasPrefix(strings.TrimSpace(entry), "{") {
+			value, err := parseJSONValue(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s JSON: %w", description, err)
+			}
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s JSON must be an object", description)
+			}
+			for key, val := range object {
+				result[key] = val
+			}
+			continue
+		}
+
+		key, raw, found := strings.Cut(entry, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid %s '%s': expected key=value or a JSON object", description, entry)
+		}
+
+		if value, err := parseJSONValue(raw); err == nil {
+			result[key] = value
+		} else {
+			result[key] = raw
+		}
+	}
+
+	return result, nil
+}
+
+// startOptionsSummary is the structured form of the options a workflow was started with
+type startOptionsSummary struct {
+	ExecutionTimeout string                 `json:"executionTimeout,omitempty" yaml:"executionTimeout,omitempty"`
+	RunTimeout       string                 `json:"runTimeout,omitempty" yaml:"runTimeout,omitempty"`
+	TaskTimeout      string                 `json:"taskTimeout,omitempty" yaml:"taskTimeout,omitempty"`
+	IDReusePolicy    string                 `json:"idReusePolicy,omitempty" yaml:"idReusePolicy,omitempty"`
+	RetryPolicy      *retryPolicySummary    `json:"retryPolicy,omitempty" yaml:"retryPolicy,omitempty"`
+	CronSchedule     string                 `json:"cronSchedule,omitempty" yaml:"cronSchedule,omitempty"`
+	StartDelay       string                 `json:"startDelay,omitempty" yaml:"startDelay,omitempty"`
+	Memo             map[string]interface{} `json:"memo,omitempty" yaml:"memo,omitempty"`
+	SearchAttributes map[string]interface{} `json:"searchAttributes,omitempty" yaml:"searchAttributes,omitempty"`
+}
+
+// retryPolicySummary is the structured form of a retry policy
+type retryPolicySummary struct {
+	InitialInterval        string   `json:"initialInterval,omitempty" yaml:"initialInterval,omitempty"`
+	BackoffCoefficient     float64  `json:"backoffCoefficient,omitempty" yaml:"backoffCoefficient,omitempty"`
+	MaximumInterval        string   `json:"maximumInterval,omitempty" yaml:"maximumInterval,omitempty"`
+	MaximumAttempts        int32    `json:"maximumAttempts,omitempty" yaml:"maximumAttempts,omitempty"`
+	NonRetryableErrorTypes []string `json:"nonRetryableErrorTypes,omitempty" yaml:"nonRetryableErrorTypes,omitempty"`
+}
+
+// formatDuration renders a duration for display, leaving unset durations empty
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return d.String()
+}
+
+// newStartOptionsSummary describes the effective start options
+func newStartOptionsSummary(options client.StartWorkflowOptions) startOptionsSummary {
+	summary := startOptionsSummary{
+		ExecutionTimeout: formatDuration(options.WorkflowExecutionTimeout),
+		RunTimeout:       formatDuration(options.WorkflowRunTimeout),
+		TaskTimeout:      formatDuration(options.WorkflowTaskTimeout),
+		CronSchedule:     options.CronSchedule,
+		StartDelay:       formatDuration(options.StartDelay),
+		Memo:             options.Memo,
+		SearchAttributes: options.SearchAttributes,
+	}
+
+	if options.WorkflowIDReusePolicy != enums.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		summary.IDReusePolicy = enums.WorkflowIdReusePolicy_name[int32(options.WorkflowIDReusePolicy)]
+	}
+
+	if policy := options.RetryPolicy; policy != nil {
+		summary.RetryPolicy = &retryPolicySummary{
+			InitialInterval:        formatDuration(policy.InitialInterval),
+			BackoffCoefficient:     policy.BackoffCoefficient,
+			MaximumInterval:        formatDuration(policy.MaximumInterval),
+			MaximumAttempts:        policy.MaximumAttempts,
+			NonRetryableErrorTypes: policy.NonRetryableErrorTypes,
+		}
+	}
+
+	return summary
+}
+
+// printStartOptions prints the start options that differ from the server defaults
+func printStartOptions(summary startOptionsSummary) {
+	lines := []struct {
+		label string
+		value string
+	}{
+		{"Execution Timeout", summary.ExecutionTimeout},
+		{"Run Timeout", summary.RunTimeout},
+		{"Task Timeout", summary.TaskTimeout},
+		{"ID Reuse Policy", summary.IDReusePolicy},
+		{"Cron Schedule", summary.CronSchedule},
+		{"Start Delay", summary.StartDelay},
+	}
+
+	for _, line := range lines {
+		if line.value != "" {
+			fmt.Printf("%s: %s\n", line.label, line.value)
+		}
+	}
+
+	if policy := summary.RetryPolicy; policy != nil {
+		fmt.Println("Retry Policy:")
+		if policy.InitialInterval != "" {
+			fmt.Printf("  Initial Interval: %s\n", policy.InitialInterval)
+		}
+		if policy.BackoffCoefficient != 0 {
+			fmt.Printf("  Backoff Coefficient: %v\n", policy.BackoffCoefficient)
+		}
+		if policy.MaximumInterval != "" {
+			fmt.Printf("  Maximum Interval: %s\n", policy.MaximumInterval)
+		}
+		if policy.MaximumAttempts != 0 {
+			fmt.Printf("  Maximum Attempts: %d\n", policy.MaximumAttempts)
+		}
+		if len(policy.NonRetryableErrorTypes) > 0 {
+			fmt.Printf("  Non-Retryable Error Types: %s\n", strings.Join(policy.NonRetryableErrorTypes, ", "))
+		}
+	}
+
+	if len(summary.Memo) > 0 {
+		fmt.Printf("Memo:\n%s\n", formatJSON(summary.Memo))
+	}
+	if len(summary.SearchAttributes) > 0 {
+		fmt.Printf("Search Attributes:\n%s\n", formatJSON(summary.SearchAttributes))
+	}
+}
@@ -0,0 +1,144 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+// pollerSummary is the structured form of a worker polling a task queue
+type pollerSummary struct {
+	Identity       string     `json:"identity" yaml:"identity"`
+	LastAccessTime *time.Time `json:"lastAccessTime,omitempty" yaml:"lastAccessTime,omitempty"`
+	RatePerSecond  float64    `json:"ratePerSecond" yaml:"ratePerSecond"`
+}
+
+// taskQueueTypeSummary is the structured form of one task type on a task queue
+type taskQueueTypeSummary struct {
+	Pollers          []pollerSummary `json:"pollers" yaml:"pollers"`
+	BacklogCountHint *int64          `json:"backlogCountHint,omitempty" yaml:"backlogCountHint,omitempty"`
+	RatePerSecond    float64         `json:"ratePerSecond,omitempty" yaml:"ratePerSecond,omitempty"`
+}
+
+// taskQueueDescription is the structured output of task-queue describe
+type taskQueueDescription struct {
+	Name     string               `json:"name" yaml:"name"`
+	Workflow taskQueueTypeSummary `json:"workflow" yaml:"workflow"`
+	Activity taskQueueTypeSummary `json:"activity" yaml:"activity"`
+}
+
+// newTaskQueueCommand creates the command group for inspecting task queues
+func newTaskQueueCommand(config *TemporalConfig) *cli.Command {
+	return &cli.Command{
+		Name:  "task-queue",
+		Usage: "Inspect task queues",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "describe",
+				Usage:     "Show the workers polling a task queue and its backlog",
+				ArgsUsage: "[task-queue]",
+				Action: func(c *cli.Context) error {
+					return describeTaskQueue(c, *config)
+				},
+			},
+		},
+	}
+}
+
+// describeTaskQueue shows the pollers and backlog for the workflow and activity
+// tasks of a task queue
+func describeTaskQueue(c *cli.Context, config TemporalConfig) error {
+	name := c.Args().First()
+	if name == "" {
+		name = config.TaskQueue
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	description := taskQueueDescription{Name: name}
+	for _, taskType := range []struct {
+		queueType enums.TaskQueueType
+		summary   *taskQueueTypeSummary
+	}{
+		{enums.TASK_QUEUE_TYPE_WORKFLOW, &description.Workflow},
+		{enums.TASK_QUEUE_TYPE_ACTIVITY, &description.Activity},
+	} {
+		resp, err := temporalClient.WorkflowService().DescribeTaskQueue(ctx, &workflowservice.DescribeTaskQueueRequest{
+			Namespace: config.Namespace,
+			TaskQueue: &taskqueue.TaskQueue{
+				Name: name,
+				Kind: enums.TASK_QUEUE_KIND_NORMAL,
+			},
+			TaskQueueType:          taskType.queueType,
+			IncludeTaskQueueStatus: true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to describe task queue: %w", err)
+		}
+
+		taskType.summary.Pollers = []pollerSummary{}
+		for _, poller := range resp.GetPollers() {
+			taskType.summary.Pollers = append(taskType.summary.Pollers, pollerSummary{
+				Identity:       poller.GetIdentity(),
+				LastAccessTime: poller.GetLastAccessTime(),
+				RatePerSecond:  poller.GetRatePerSecond(),
+			})
+		}
+
+		// Not every server reports status, so leave the backlog unset when it doesn't
+		if status := resp.GetTaskQueueStatus(); status != nil {
+			backlog := status.GetBacklogCountHint()
+			taskType.summary.BacklogCountHint = &backlog
+			taskType.summary.RatePerSecond = status.GetRatePerSecond()
+		}
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, description)
+	}
+
+	fmt.Printf("%s%s==== Task Queue: %s ====%s\n", colorBold, colorBlue, name, colorReset)
+	printTaskQueueType("Workflow", description.Workflow)
+	printTaskQueueType("Activity", description.Activity)
+	return nil
+}
+
+// printTaskQueueType prints the pollers and backlog for one task type
+func printTaskQueueType(label string, summary taskQueueTypeSummary) {
+	fmt.Printf("\n%s%s Tasks%s\n", colorBold, label, colorReset)
+
+	if summary.BacklogCountHint != nil {
+		fmt.Printf("Backlog: ~%d tasks\n", *summary.BacklogCountHint)
+	}
+
+	if len(summary.Pollers) == 0 {
+		fmt.Printf("%sNo workers are polling for %s tasks%s\n", colorRed, label, colorReset)
+		return
+	}
+
+	fmt.Printf("Pollers (%d):\n", len(summary.Pollers))
+	for _, poller := range summary.Pollers {
+		lastAccess := "-"
+		if poller.LastAccessTime != nil {
+			lastAccess = fmt.Sprintf("%s (%s ago)",
+				poller.LastAccessTime.Local().Format(time.RFC3339),
+				time.Since(*poller.LastAccessTime).Round(time.Second))
+		}
+		fmt.Printf("  %s\n", poller.Identity)
+		fmt.Printf("    Last Access: %s\n", lastAccess)
+		fmt.Printf("    Rate: %v/s\n", poller.RatePerSecond)
+	}
+}
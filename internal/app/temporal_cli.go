@@ -4,8 +4,12 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"os"
+	"os/signal"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -13,21 +17,33 @@ import (
 	"github.com/urfave/cli/v2"
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
 )
 
 // TemporalConfig holds configuration for connecting to Temporal
 type TemporalConfig struct {
-	Address         string
-	Namespace       string
-	TaskQueue       string
-	WorkflowID      string
-	Debug           bool
-	CPUProfile      string
-	MemProfile      string
-	EnableProfiling bool
-	ProfilePort     int
+	Address               string
+	Namespace             string
+	TaskQueue             string
+	WorkflowID            string
+	RunID                 string
+	TLS                   bool
+	TLSCACert             string
+	TLSCert               string
+	TLSKey                string
+	TLSServerName         string
+	TLSInsecureSkipVerify bool
+	ConfigFile            string
+	Profile               string
+	Output                string
+	Debug                 bool
+	CPUProfile            string
+	MemProfile            string
+	EnableProfiling       bool
+	ProfilePort           int
 }
 
 // NewTemporalCLI creates a new CLI application for interacting with Temporal
@@ -40,6 +56,10 @@ func NewTemporalCLI() *cli.App {
 		UseShortOptionHandling: true,
 		EnableBashCompletion:   true,
 		AllowExtFlags:          true, // Allow flags after commands
+		// JSON values contain commas, so repeated flags must not be split on them
+		DisableSliceFlagSeparator: true,
+		// main reports errors and picks the exit code, after closing profile files
+		ExitErrHandler: func(c *cli.Context, err error) {},
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:        "address",
@@ -73,6 +93,70 @@ func NewTemporalCLI() *cli.App {
 				Destination: &config.WorkflowID,
 				EnvVars:     []string{"TEMPORAL_WORKFLOW_ID"},
 			},
+			&cli.StringFlag{
+				Name:        "run-id",
+				Aliases:     []string{"r"},
+				Usage:       "Run ID for operations on a specific workflow run (defaults to the latest run)",
+				Destination: &config.RunID,
+			},
+			&cli.BoolFlag{
+				Name:        "tls",
+				Usage:       "Connect using TLS with the system CA pool",
+				Destination: &config.TLS,
+				EnvVars:     []string{"TEMPORAL_TLS"},
+			},
+			&cli.StringFlag{
+				Name:        "tls-ca",
+				Usage:       "Path to a CA certificate bundle used to verify the server",
+				Destination: &config.TLSCACert,
+				EnvVars:     []string{"TEMPORAL_TLS_CA"},
+			},
+			&cli.StringFlag{
+				Name:        "tls-cert",
+				Usage:       "Path to the client certificate for mTLS",
+				Destination: &config.TLSCert,
+				EnvVars:     []string{"TEMPORAL_TLS_CERT"},
+			},
+			&cli.StringFlag{
+				Name:        "tls-key",
+				Usage:       "Path to the client private key for mTLS",
+				Destination: &config.TLSKey,
+				EnvVars:     []string{"TEMPORAL_TLS_KEY"},
+			},
+			&cli.StringFlag{
+				Name:        "tls-server-name",
+				Usage:       "Override the server name used to verify the server certificate",
+				Destination: &config.TLSServerName,
+				EnvVars:     []string{"TEMPORAL_TLS_SERVER_NAME"},
+			},
+			&cli.BoolFlag{
+				Name:        "tls-insecure-skip-verify",
+				Usage:       "Skip verification of the server certificate (insecure)",
+				Destination: &config.TLSInsecureSkipVerify,
+				EnvVars:     []string{"TEMPORAL_TLS_INSECURE_SKIP_VERIFY"},
+			},
+			&cli.StringFlag{
+				Name:        "config",
+				Usage:       "Path to the config file holding connection profiles",
+				Value:       defaultConfigPath(),
+				Destination: &config.ConfigFile,
+				EnvVars:     []string{"TEMPURAL_CONFIG"},
+			},
+			&cli.StringFlag{
+				Name:        "profile",
+				Aliases:     []string{"p"},
+				Usage:       "Connection profile to use from the config file",
+				Destination: &config.Profile,
+				EnvVars:     []string{"TEMPURAL_PROFILE"},
+			},
+			&cli.StringFlag{
+				Name:        "output",
+				Aliases:     []string{"o"},
+				Usage:       "Output format: table, json, jsonl or yaml",
+				Value:       outputTable,
+				Destination: &config.Output,
+				EnvVars:     []string{"TEMPURAL_OUTPUT"},
+			},
 			&cli.BoolFlag{
 				Name:        "debug",
 				Aliases:     []string{"d"},
@@ -108,15 +192,37 @@ func NewTemporalCLI() *cli.App {
 		Commands: []*cli.Command{
 			{
 				Name:  "list",
-				Usage: "List running workflows",
+				Usage: "List workflows (running workflows unless filters are given)",
+				Flags: append(listFilterFlags(),
+					&cli.IntFlag{
+						Name:    "limit",
+						Aliases: []string{"l"},
+						Usage:   "Maximum number of workflows to list (0 for no limit)",
+						Value:   100,
+					},
+				),
 				Action: func(c *cli.Context) error {
 					return listWorkflows(c, config)
 				},
 			},
+			{
+				Name:  "count",
+				Usage: "Count workflows matching a query, optionally grouped (all workflows unless filters are given)",
+				Flags: append(listFilterFlags(),
+					&cli.StringFlag{
+						Name:    "group-by",
+						Aliases: []string{"g"},
+						Usage:   "Count per value of this field, e.g. ExecutionStatus",
+					},
+				),
+				Action: func(c *cli.Context) error {
+					return countWorkflows(c, config)
+				},
+			},
 			{
 				Name:  "start",
 				Usage: "Start a new workflow",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					&cli.StringFlag{
 						Name:     "workflow-type",
 						Aliases:  []string{"t"},
@@ -124,11 +230,15 @@ func NewTemporalCLI() *cli.App {
 						Value:    "",
 						Required: true,
 					},
-					&cli.StringFlag{
+					&cli.StringSliceFlag{
 						Name:    "input",
 						Aliases: []string{"i"},
-						Usage:   "JSON input for the workflow",
-						Value:   "{}",
+						Usage:   "JSON input for the workflow; repeat for multiple arguments (\"-\" reads from stdin)",
+						Value:   cli.NewStringSlice("{}"),
+					},
+					&cli.BoolFlag{
+						Name:  "input-args",
+						Usage: "Treat the input as a JSON array whose elements are passed as separate arguments",
 					},
 					&cli.StringFlag{
 						Name:    "workflow-id",
@@ -142,7 +252,15 @@ func NewTemporalCLI() *cli.App {
 						Usage:   "Build workflow input interactively with prompts",
 						Value:   false,
 					},
-				},
+					&cli.BoolFlag{
+						Name:  "wait",
+						Usage: "Wait for the workflow to finish and print its result",
+					},
+					&cli.DurationFlag{
+						Name:  "wait-timeout",
+						Usage: "Maximum time to wait for the workflow result with --wait (0 waits forever)",
+					},
+				}, startOptionFlags()...),
 				Action: func(c *cli.Context) error {
 					return startWorkflow(c, config)
 				},
@@ -162,6 +280,30 @@ func NewTemporalCLI() *cli.App {
 					return describeWorkflow(c, config)
 				},
 			},
+			{
+				Name:  "history",
+				Usage: "Show the event history of a workflow",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "run-id",
+						Aliases: []string{"r"},
+						Usage:   "Run ID of the workflow (optional)",
+					},
+					&cli.BoolFlag{
+						Name:    "detail",
+						Aliases: []string{"D"},
+						Usage:   "Show every attribute of each event instead of one line per event",
+					},
+					&cli.BoolFlag{
+						Name:    "follow",
+						Aliases: []string{"f"},
+						Usage:   "Keep streaming new events until the workflow closes; the exit status reflects how it closed",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return showHistory(c, config)
+				},
+			},
 			{
 				Name:  "signal",
 				Usage: "Signal a workflow",
@@ -172,17 +314,174 @@ func NewTemporalCLI() *cli.App {
 						Usage:    "Name of the signal to send",
 						Required: true,
 					},
-					&cli.StringFlag{
+					&cli.StringSliceFlag{
 						Name:    "input",
 						Aliases: []string{"i"},
-						Usage:   "JSON input for the signal",
-						Value:   "{}",
+						Usage:   "JSON input for the signal; repeat for multiple arguments (\"-\" reads from stdin)",
+						Value:   cli.NewStringSlice("{}"),
+					},
+					&cli.BoolFlag{
+						Name:  "input-args",
+						Usage: "Treat the input as a JSON array whose elements are passed as separate arguments",
 					},
 				},
 				Action: func(c *cli.Context) error {
 					return signalWorkflow(c, config)
 				},
 			},
+			{
+				Name:  "signal-with-start",
+				Usage: "Signal a workflow, starting it first if it isn't running",
+				Flags: append([]cli.Flag{
+					&cli.StringFlag{
+						Name:     "workflow-type",
+						Aliases:  []string{"t"},
+						Usage:    "Type of workflow to start if it isn't running",
+						Required: true,
+					},
+					&cli.StringSliceFlag{
+						Name:    "input",
+						Aliases: []string{"i"},
+						Usage:   "JSON input for the workflow; repeat for multiple arguments (\"-\" reads from stdin)",
+						Value:   cli.NewStringSlice("{}"),
+					},
+					&cli.BoolFlag{
+						Name:  "input-args",
+						Usage: "Treat the workflow input as a JSON array whose elements are passed as separate arguments",
+					},
+					&cli.StringFlag{
+						Name:    "workflow-id",
+						Aliases: []string{"w", "id"},
+						Usage:   "ID of the workflow to signal or start (overrides global workflow-id flag)",
+					},
+					&cli.StringFlag{
+						Name:     "signal-name",
+						Aliases:  []string{"s"},
+						Usage:    "Name of the signal to send",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "signal-input",
+						Usage: "JSON input for the signal (\"-\" reads from stdin)",
+						Value: "{}",
+					},
+				}, startOptionFlags()...),
+				Action: func(c *cli.Context) error {
+					return signalWithStartWorkflow(c, config)
+				},
+			},
+			{
+				Name:  "update",
+				Usage: "Send an update to a workflow and wait for its result",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "update-name",
+						Aliases:  []string{"u"},
+						Usage:    "Name of the update handler to invoke",
+						Required: true,
+					},
+					&cli.StringSliceFlag{
+						Name:    "input",
+						Aliases: []string{"i"},
+						Usage:   "JSON input for the update; repeat for multiple arguments (\"-\" reads from stdin)",
+						Value:   cli.NewStringSlice("{}"),
+					},
+					&cli.BoolFlag{
+						Name:  "input-args",
+						Usage: "Treat the input as a JSON array whose elements are passed as separate arguments",
+					},
+					&cli.StringFlag{
+						Name:  "update-id",
+						Usage: "ID for the update, so a retried request is not applied twice (default: generated)",
+					},
+					&cli.StringFlag{
+						Name:  "wait-for",
+						Usage: "Stage to wait for: completed (print the result) or accepted",
+						Value: "completed",
+					},
+					&cli.DurationFlag{
+						Name:  "wait-timeout",
+						Usage: "Maximum time to wait (default: wait forever)",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return updateWorkflow(c, config)
+				},
+			},
+			{
+				Name:  "cancel",
+				Usage: "Request cancellation of a workflow",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:    "yes",
+						Aliases: []string{"y"},
+						Usage:   "Skip the confirmation prompt",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return cancelWorkflow(c, config)
+				},
+			},
+			{
+				Name:  "terminate",
+				Usage: "Terminate a workflow immediately",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "reason",
+						Usage: "Reason for terminating, recorded in the workflow history",
+					},
+					&cli.StringFlag{
+						Name:  "details",
+						Usage: "JSON details recorded with the termination (\"-\" reads from stdin)",
+					},
+					&cli.BoolFlag{
+						Name:    "yes",
+						Aliases: []string{"y"},
+						Usage:   "Skip the confirmation prompt",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return terminateWorkflow(c, config)
+				},
+			},
+			{
+				Name:  "reset",
+				Usage: "Reset a workflow to an earlier workflow task",
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:    "event-id",
+						Aliases: []string{"e"},
+						Usage:   "ID of the WorkflowTaskCompleted event to reset to",
+					},
+					&cli.StringFlag{
+						Name:    "type",
+						Aliases: []string{"t"},
+						Usage:   "Reset point to use instead of an event ID: LastWorkflowTask, FirstWorkflowTask or LastContinuedAsNew",
+					},
+					&cli.StringFlag{
+						Name:  "reason",
+						Usage: "Reason for the reset, recorded in the workflow history",
+					},
+					&cli.StringFlag{
+						Name:  "reapply-type",
+						Usage: "Events after the reset point to reapply to the new run: Signal or None",
+						Value: "Signal",
+					},
+					&cli.BoolFlag{
+						Name:    "list",
+						Aliases: []string{"l"},
+						Usage:   "List the reset points in the workflow's history instead of resetting",
+					},
+					&cli.BoolFlag{
+						Name:    "yes",
+						Aliases: []string{"y"},
+						Usage:   "Skip the confirmation prompt",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return resetWorkflow(c, config)
+				},
+			},
 			{
 				Name:  "query",
 				Usage: "Query a workflow",
@@ -193,17 +492,34 @@ func NewTemporalCLI() *cli.App {
 						Usage:    "Type of query to execute",
 						Required: true,
 					},
-					&cli.StringFlag{
+					&cli.StringSliceFlag{
 						Name:    "args",
 						Aliases: []string{"a"},
-						Usage:   "JSON arguments for the query",
-						Value:   "{}",
+						Usage:   "JSON argument for the query; repeat for multiple arguments (\"-\" reads from stdin)",
+						Value:   cli.NewStringSlice("{}"),
+					},
+					&cli.BoolFlag{
+						Name:  "input-args",
+						Usage: "Treat the argument as a JSON array whose elements are passed as separate arguments",
 					},
 				},
 				Action: func(c *cli.Context) error {
 					return queryWorkflow(c, config)
 				},
 			},
+			{
+				Name:  "stack",
+				Usage: "Show where a workflow's coroutines are blocked using the __stack_trace query",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "raw",
+						Usage: "Print the stack trace exactly as the worker returned it",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return stackWorkflow(c, config)
+				},
+			},
 			{
 				Name:  "infer-params",
 				Usage: "Infer parameter structure for a workflow type",
@@ -217,9 +533,29 @@ func NewTemporalCLI() *cli.App {
 					&cli.IntFlag{
 						Name:    "limit",
 						Aliases: []string{"l"},
-						Usage:   "Maximum number of workflows to examine",
+						Usage:   "Maximum number of workflows to examine, spread across all matching runs",
 						Value:   3,
 					},
+					&cli.StringFlag{
+						Name:  "query",
+						Usage: "Visibility query narrowing which runs are examined",
+					},
+					&cli.StringFlag{
+						Name:  "since",
+						Usage: "Only examine runs started after this time (RFC3339, YYYY-MM-DD, or a duration like 24h meaning that long ago)",
+					},
+					&cli.IntFlag{
+						Name:    "concurrency",
+						Aliases: []string{"c"},
+						Usage:   "Number of histories to fetch in parallel",
+						Value:   10,
+					},
+					&cli.StringFlag{
+						Name:    "kind",
+						Aliases: []string{"k"},
+						Usage:   "Payloads to infer: input, or signal, activity, result or update for a schema per name",
+						Value:   inferKindInput,
+					},
 					&cli.BoolFlag{
 						Name:    "json-schema",
 						Aliases: []string{"j"},
@@ -232,13 +568,46 @@ func NewTemporalCLI() *cli.App {
 						Usage:   "Output raw schema without pretty printing",
 						Value:   false,
 					},
+					&cli.StringFlag{
+						Name:    "emit",
+						Aliases: []string{"e"},
+						Usage:   "Generate type definitions instead: go, typescript, python, pydantic or proto",
+					},
+					&cli.StringFlag{
+						Name:  "package",
+						Usage: "Package name for generated Go and proto code",
+						Value: "params",
+					},
 				},
 				Action: func(c *cli.Context) error {
 					return inferWorkflowParams(c, config)
 				},
 			},
+			newConfigCommand(&config),
+			newBatchCommand(&config),
+			newScheduleCommand(&config),
+			newTaskQueueCommand(&config),
+			newNamespaceCommand(&config),
+			newSearchAttributeCommand(&config),
 		},
 		Before: func(c *cli.Context) error {
+			// Fill in settings from the selected profile. The config command
+			// manages profiles itself, so it may refer to ones that don't exist yet.
+			if c.Args().First() != "config" {
+				if err := applyProfile(c, &config); err != nil {
+					return err
+				}
+			}
+
+			if err := validateOutputFormat(config.Output); err != nil {
+				return err
+			}
+
+			// Only use colors when a person is reading the output
+			if os.Getenv("NO_COLOR") != "" || !stdoutIsTerminal() || isStructuredOutput(config) {
+				disableColors()
+			}
+
 			// Setup profiling before any command runs
 			return SetupProfiling(config)
 		},
@@ -249,49 +618,250 @@ func NewTemporalCLI() *cli.App {
 
 // getTemporalClient creates a new Temporal client
 func getTemporalClient(config TemporalConfig) (client.Client, error) {
+	tlsConfig, err := buildTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
 	return client.Dial(client.Options{
 		HostPort:  config.Address,
 		Namespace: config.Namespace,
+		ConnectionOptions: client.ConnectionOptions{
+			TLS: tlsConfig,
+		},
 	})
 }
 
-// listWorkflows lists running workflows
+// listWorkflows lists workflows matching the visibility filters given on the command line
 func listWorkflows(c *cli.Context, config TemporalConfig) error {
+	query, err := buildListQuery(c, c.String("query"), []string{"Running"})
+	if err != nil {
+		return err
+	}
+
 	temporalClient, err := getTemporalClient(config)
 	if err != nil {
 		return fmt.Errorf("failed to create Temporal client: %w", err)
 	}
 	defer temporalClient.Close()
 
-	// Create a context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	// Create a context with timeout, allowing for several pages of results
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	// Use the native API to list workflows
-	listRequest := &workflowservice.ListWorkflowExecutionsRequest{
-		// Default to open workflows
-		Query: fmt.Sprintf("ExecutionStatus=%d", int32(enums.WORKFLOW_EXECUTION_STATUS_RUNNING)),
-	}
-
-	resp, err := temporalClient.ListWorkflow(ctx, listRequest)
+	executions, more, err := listWorkflowExecutions(ctx, temporalClient, query, c.Int("limit"))
 	if err != nil {
 		return fmt.Errorf("failed to list workflows: %w", err)
 	}
 
+	if isStructuredOutput(config) {
+		summaries := make([]workflowSummary, 0, len(executions))
+		for _, execution := range executions {
+			summaries = append(summaries, newWorkflowSummary(execution))
+		}
+		if more {
+			infof(config, "Note: More workflows match; increase --limit to see them.\n")
+		}
+		return printStructured(config, summaries)
+	}
+
 	// Display the results
-	fmt.Printf("Found %d workflows:\n", len(resp.Executions))
-	for i, execution := range resp.Executions {
-		fmt.Printf("%d. ID: %s, Type: %s, Status: %s\n",
+	fmt.Printf("Found %d workflows:\n", len(executions))
+	for i, execution := range executions {
+		fmt.Printf("%d. ID: %s, Type: %s, Status: %s, Start Time: %v\n",
 			i+1,
 			execution.Execution.WorkflowId,
 			execution.Type.Name,
 			enums.WorkflowExecutionStatus_name[int32(execution.Status)],
+			execution.StartTime,
 		)
 	}
 
+	if more {
+		fmt.Printf("%sNote:%s More workflows match; increase --limit to see them.\n", colorYellow, colorReset)
+	}
+
 	return nil
 }
 
+// listFilterFlags returns the flags that select workflows for list and count
+func listFilterFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "query",
+			Usage: "Raw visibility query, e.g. \"WorkflowType='ProcessOrder' AND ExecutionStatus='Failed'\"",
+		},
+		&cli.StringSliceFlag{
+			Name:    "status",
+			Aliases: []string{"s"},
+			Usage:   "Only include workflows with this status (Running, Completed, Failed, Canceled, Terminated, ContinuedAsNew, TimedOut, or all)",
+		},
+		&cli.StringFlag{
+			Name:    "type",
+			Aliases: []string{"t"},
+			Usage:   "Only include workflows of this type",
+		},
+		&cli.StringFlag{
+			Name:  "started-after",
+			Usage: "Only include workflows started after this time (RFC3339, YYYY-MM-DD, or a duration like 1h meaning that long ago)",
+		},
+		&cli.StringFlag{
+			Name:  "started-before",
+			Usage: "Only include workflows started before this time (RFC3339, YYYY-MM-DD, or a duration like 1h meaning that long ago)",
+		},
+	}
+}
+
+// buildListQuery combines a raw query with the --status, --type and --started-*
+// flags into a single visibility query. Without any filters it selects workflows
+// with the default statuses, or all workflows if there are none.
+func buildListQuery(c *cli.Context, query string, defaultStatuses []string) (string, error) {
+	var clauses []string
+
+	if query := strings.TrimSpace(query); query != "" {
+		clauses = append(clauses, "("+query+")")
+	}
+
+	// Accept both repeated flags and comma-separated lists of statuses
+	var statuses []string
+	for _, status := range c.StringSlice("status") {
+		statuses = append(statuses, strings.Split(status, ",")...)
+	}
+	if len(statuses) == 0 && len(clauses) == 0 {
+		statuses = defaultStatuses
+	}
+	if len(statuses) > 0 {
+		statusClause, err := buildStatusClause(statuses)
+		if err != nil {
+			return "", err
+		}
+		if statusClause != "" {
+			clauses = append(clauses, statusClause)
+		}
+	}
+
+	if workflowType := c.String("type"); workflowType != "" {
+		clauses = append(clauses, "WorkflowType="+quoteQueryValue(workflowType))
+	}
+
+	for _, bound := range []struct {
+		flag     string
+		operator string
+	}{
+		{"started-after", ">="},
+		{"started-before", "<="},
+	} {
+		value := c.String(bound.flag)
+		if value == "" {
+			continue
+		}
+		t, err := parseTimeFlag(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid --%s: %w", bound.flag, err)
+		}
+		clauses = append(clauses, fmt.Sprintf("StartTime %s '%s'", bound.operator, t.UTC().Format(time.RFC3339)))
+	}
+
+	return strings.Join(clauses, " AND "), nil
+}
+
+// quoteQueryValue quotes a string for use in a visibility query, escaping
+// quotes and backslashes so the value can't end the string early
+func quoteQueryValue(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// buildStatusClause turns status names into an ExecutionStatus filter.
+// The special status "all" disables status filtering.
+func buildStatusClause(statuses []string) (string, error) {
+	var conditions []string
+	for _, status := range statuses {
+		if strings.EqualFold(status, "all") {
+			return "", nil
+		}
+
+		name, ok := lookupWorkflowStatus(status)
+		if !ok {
+			return "", fmt.Errorf("unknown workflow status '%s'", status)
+		}
+		conditions = append(conditions, fmt.Sprintf("ExecutionStatus='%s'", name))
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0], nil
+	}
+	return "(" + strings.Join(conditions, " OR ") + ")", nil
+}
+
+// lookupWorkflowStatus finds the canonical name of a workflow status
+func lookupWorkflowStatus(status string) (string, bool) {
+	name, value, ok := lookupEnumName(enums.WorkflowExecutionStatus_value, status)
+	if !ok || value == int32(enums.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED) {
+		return "", false
+	}
+	return name, true
+}
+
+// lookupEnumName finds an enum value by name, ignoring case, dashes and
+// underscores so that "timed_out" matches "TimedOut"
+func lookupEnumName(values map[string]int32, name string) (string, int32, bool) {
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(name)
+	for candidate, value := range values {
+		if strings.EqualFold(candidate, normalized) {
+			return candidate, value, true
+		}
+	}
+	return "", 0, false
+}
+
+// parseTimeFlag parses an absolute time (RFC3339 or YYYY-MM-DD) or a duration,
+// which is interpreted as that long before now
+func parseTimeFlag(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("'%s' is not an RFC3339 time, a date, or a duration", value)
+}
+
+// listWorkflowExecutions follows page tokens until limit executions have been
+// collected (0 means no limit). It reports whether more executions remain.
+func listWorkflowExecutions(ctx context.Context, temporalClient client.Client, query string, limit int) ([]*workflow.WorkflowExecutionInfo, bool, error) {
+	var executions []*workflow.WorkflowExecutionInfo
+	var nextPageToken []byte
+
+	for {
+		listRequest := &workflowservice.ListWorkflowExecutionsRequest{
+			Query:         query,
+			NextPageToken: nextPageToken,
+		}
+		if limit > 0 && limit-len(executions) < 1000 {
+			listRequest.PageSize = int32(limit - len(executions))
+		}
+
+		resp, err := temporalClient.ListWorkflow(ctx, listRequest)
+		if err != nil {
+			return nil, false, err
+		}
+
+		executions = append(executions, resp.Executions...)
+		nextPageToken = resp.NextPageToken
+
+		if limit > 0 && len(executions) >= limit {
+			more := len(executions) > limit || len(nextPageToken) > 0
+			return executions[:limit], more, nil
+		}
+		if len(nextPageToken) == 0 {
+			return executions, false, nil
+		}
+	}
+}
+
 // startWorkflow starts a new workflow
 func startWorkflow(c *cli.Context, config TemporalConfig) error {
 	temporalClient, err := getTemporalClient(config)
@@ -317,11 +887,10 @@ func startWorkflow(c *cli.Context, config TemporalConfig) error {
 	// Generate a random workflow ID if still not provided
 	if workflowID == "" {
 		workflowID = fmt.Sprintf("workflow-%d", time.Now().Unix())
-		fmt.Printf("No workflow ID provided, using auto-generated ID: %s\n", workflowID)
+		infof(config, "No workflow ID provided, using auto-generated ID: %s\n", workflowID)
 	}
 
-	var input string
-	var inputData interface{}
+	var args []interface{}
 
 	// Handle interactive mode if enabled
 	if c.Bool("interactive") {
@@ -329,28 +898,29 @@ func startWorkflow(c *cli.Context, config TemporalConfig) error {
 			colorBold, colorBlue, workflowType, colorReset)
 
 		// Try to infer workflow parameters if available
-		schema, err := inferWorkflowSchemaForType(ctx, temporalClient, workflowType)
+		schemas, err := inferArgumentSchemasForType(ctx, temporalClient, workflowType)
 		if err != nil {
 			fmt.Printf("%sNote:%s Couldn't find existing workflows to infer parameters, using generic input.\n\n",
 				colorYellow, colorReset)
-			// Build generic input when no schema is available
-			inputData = buildInputInteractively(nil)
-		} else {
-			// Build input based on schema
-			inputData = buildInputInteractivelyFromSchema(schema)
+			// A nil schema builds generic input
+			schemas = []map[string]interface{}{nil}
 		}
 
-		// Convert the input data to JSON
-		jsonBytes, err := json.Marshal(inputData)
-		if err != nil {
-			return fmt.Errorf("failed to marshal input data: %w", err)
+		// Build one argument per position, each from its own schema
+		for i, schema := range schemas {
+			if len(schemas) > 1 {
+				fmt.Printf("%sArgument %d of %d%s\n", colorBold, i+1, len(schemas), colorReset)
+			}
+			args = append(args, buildInputInteractivelyFromSchema(schema))
 		}
-		input = string(jsonBytes)
 
 		// Show the final input
 		fmt.Printf("\n%sFinal Input:%s\n", colorGreen, colorReset)
-		prettyJSON, _ := json.MarshalIndent(inputData, "", "  ")
-		fmt.Println(string(prettyJSON))
+		if len(args) == 1 {
+			fmt.Println(formatJSON(args[0]))
+		} else {
+			fmt.Println(formatJSON(args))
+		}
 		fmt.Println()
 
 		// Confirm with user
@@ -358,107 +928,106 @@ func startWorkflow(c *cli.Context, config TemporalConfig) error {
 			return fmt.Errorf("workflow start canceled by user")
 		}
 	} else {
-		// Get input from flag value
-		inputFlag := c.String("input")
-
-		// Check if input should be read from stdin
-		if inputFlag == "-" {
-			fmt.Println("Reading input from stdin...")
-			scanner := bufio.NewScanner(os.Stdin)
-			var inputBuilder strings.Builder
-			for scanner.Scan() {
-				inputBuilder.WriteString(scanner.Text())
-			}
-			if err := scanner.Err(); err != nil {
-				return fmt.Errorf("error reading from stdin: %w", err)
-			}
-			input = inputBuilder.String()
-
-			// If input is empty, provide a warning
-			if strings.TrimSpace(input) == "" {
-				fmt.Println("Warning: Empty input received from stdin")
-				input = "{}" // Fallback to empty JSON object
-			}
-		} else {
-			// Use the input provided in the flag
-			input = inputFlag
+		// Decode each --input into a workflow argument
+		args, err = parseInputArgs(config, c.StringSlice("input"), c.Bool("input-args"), "input")
+		if err != nil {
+			return err
 		}
 	}
 
 	// Start the workflow
-	workflowOptions := client.StartWorkflowOptions{
-		ID:        workflowID,
-		TaskQueue: config.TaskQueue,
+	workflowOptions, err := buildStartWorkflowOptions(c, config, workflowID)
+	if err != nil {
+		return err
 	}
 
-	we, err := temporalClient.ExecuteWorkflow(ctx, workflowOptions, workflowType, []byte(input))
+	we, err := temporalClient.ExecuteWorkflow(ctx, workflowOptions, workflowType, args...)
 	if err != nil {
 		return fmt.Errorf("failed to start workflow: %w", err)
 	}
 
-	fmt.Printf("Started workflow execution\n")
-	fmt.Printf("Workflow ID: %s\n", we.GetID())
-	fmt.Printf("Run ID: %s\n", we.GetRunID())
+	result := startResult{
+		WorkflowID:   we.GetID(),
+		RunID:        we.GetRunID(),
+		WorkflowType: workflowType,
+		TaskQueue:    workflowOptions.TaskQueue,
+		Options:      newStartOptionsSummary(workflowOptions),
+	}
 
-	return nil
-}
+	if !isStructuredOutput(config) {
+		fmt.Printf("Started workflow execution\n")
+		fmt.Printf("Workflow ID: %s\n", we.GetID())
+		fmt.Printf("Run ID: %s\n", we.GetRunID())
+		fmt.Printf("Task Queue: %s\n", workflowOptions.TaskQueue)
+		printStartOptions(result.Options)
+	}
 
-// inferWorkflowSchemaForType attempts to infer a schema for a workflow type
-// by examining recent executions
-func inferWorkflowSchemaForType(ctx context.Context, client client.Client, workflowType string) (map[string]interface{}, error) {
-	// Find recent workflows of this type
-	query := fmt.Sprintf("WorkflowType='%s'", workflowType)
-	listRequest := &workflowservice.ListWorkflowExecutionsRequest{
-		Query: query,
+	if !c.Bool("wait") {
+		if isStructuredOutput(config) {
+			return printStructured(config, result)
+		}
+		return nil
 	}
 
-	resp, err := client.ListWorkflow(ctx, listRequest)
-	if err != nil {
-		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	infof(config, "Waiting for workflow result...\n")
+	outcome, waitErr := waitForWorkflowRun(we, c.Duration("wait-timeout"))
+	if outcome.Status == "" {
+		// Waiting timed out or the result could not be fetched
+		return waitErr
 	}
 
-	if len(resp.Executions) == 0 {
-		return nil, fmt.Errorf("no workflows of type '%s' found", workflowType)
+	if isStructuredOutput(config) {
+		result.Outcome = &outcome
+		if err := printStructured(config, result); err != nil {
+			return err
+		}
+	} else {
+		fmt.Println()
+		printWorkflowOutcome(outcome)
 	}
 
-	// Examine the first workflow we find to extract its parameter structure
-	execution := resp.Executions[0]
-	workflowID := execution.Execution.WorkflowId
-	runID := execution.Execution.RunId
+	return waitErr
+}
 
-	// Get workflow history to find start event
-	iter := client.GetWorkflowHistory(ctx, workflowID, runID, false, 0)
+// startResult is the structured output of the start command
+type startResult struct {
+	WorkflowID   string              `json:"workflowId" yaml:"workflowId"`
+	RunID        string              `json:"runId" yaml:"runId"`
+	WorkflowType string              `json:"workflowType" yaml:"workflowType"`
+	TaskQueue    string              `json:"taskQueue" yaml:"taskQueue"`
+	Options      startOptionsSummary `json:"options" yaml:"options"`
+	Outcome      *workflowOutcome    `json:"outcome,omitempty" yaml:"outcome,omitempty"`
+}
 
-	// Look for first event (workflow started)
-	for iter.HasNext() {
-		event, err := iter.Next()
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch history: %w", err)
-		}
+// inferSampleSize is how many recent executions interactive mode learns the
+// input schema from
+const inferSampleSize = 10
 
-		if event.GetEventType() == enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED {
-			startedAttrs := event.GetWorkflowExecutionStartedEventAttributes()
-			if startedAttrs != nil && startedAttrs.Input != nil {
-				payloads := startedAttrs.Input.GetPayloads()
-				if len(payloads) > 0 {
-					for _, payload := range payloads {
-						data := payload.GetData()
-						if len(data) > 0 {
-							// Try to parse as JSON
-							var jsonObj interface{}
-							if err := json.Unmarshal(data, &jsonObj); err == nil {
-								// Convert to schema representation
-								return generateJSONSchema(jsonObj, ""), nil
-							}
-						}
-					}
-				}
-			}
-			break // Only check the first event
-		}
+// inferArgumentSchemasForType attempts to infer a schema for each argument of
+// a workflow type by merging the inputs of recent executions. Positions where
+// no JSON input was seen have a nil schema.
+func inferArgumentSchemasForType(ctx context.Context, client client.Client, workflowType string) ([]map[string]interface{}, error) {
+	// Find recent workflows of this type
+	query := "WorkflowType=" + quoteQueryValue(workflowType)
+	executions, _, err := listWorkflowExecutions(ctx, client, query, inferSampleSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+
+	if len(executions) == 0 {
+		return nil, fmt.Errorf("no workflows of type '%s' found", workflowType)
 	}
 
-	return nil, fmt.Errorf("no valid input found in workflow history")
+	groups := groupValuesByName(fetchInputSamples(ctx, client, executions, inferKindInput, inferSampleSize))
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("no valid input found in workflow history")
+	}
+
+	schemas := make([]map[string]interface{}, groups[0].Args)
+	for _, group := range groups {
+		schemas[group.Arg] = generateMergedJSONSchema(group.Samples, "")
+	}
+	return schemas, nil
 }
 
 // buildInputInteractivelyFromSchema builds a workflow input object interactively based on a schema
@@ -467,36 +1036,58 @@ func buildInputInteractivelyFromSchema(schema map[string]interface{}) interface{
 		return buildInputInteractively(nil)
 	}
 
+	// Inputs with really different structures are alternatives to choose from
+	if oneOf, ok := schema["oneOf"].([]interface{}); ok && len(oneOf) > 0 {
+		schema = chooseSchemaVariant(oneOf)
+	}
+
 	fmt.Printf("Building input based on inferred schema:\n\n")
 
 	// Check if we have a properties field (indicates an object)
 	if properties, ok := schema["properties"].(map[string]interface{}); ok {
 		// Build an object with the properties from the schema
 		result := make(map[string]interface{})
+		required := schemaRequired(schema)
 
-		// Get required fields if any
-		required := make(map[string]bool)
-		if reqFields, ok := schema["required"].([]string); ok {
-			for _, field := range reqFields {
-				required[field] = true
-			}
+		// Ask for required fields first, each group in name order
+		fieldNames := make([]string, 0, len(properties))
+		for fieldName := range properties {
+			fieldNames = append(fieldNames, fieldName)
 		}
+		sort.Slice(fieldNames, func(i, j int) bool {
+			if required[fieldNames[i]] != required[fieldNames[j]] {
+				return required[fieldNames[i]]
+			}
+			return fieldNames[i] < fieldNames[j]
+		})
 
-		for fieldName, fieldSchema := range properties {
-			fieldSchemaObj, ok := fieldSchema.(map[string]interface{})
+		for _, fieldName := range fieldNames {
+			fieldSchemaObj, ok := properties[fieldName].(map[string]interface{})
 			if !ok {
 				continue
 			}
 
-			fieldType, _ := fieldSchemaObj["type"].(string)
+			fieldType, _ := schemaValueType(fieldSchemaObj)
 			isRequired := required[fieldName]
 
 			// Prompt for this field
 			fmt.Printf("Field: %s%s%s", colorBold, fieldName, colorReset)
 			if isRequired {
 				fmt.Printf(" %s(required)%s", colorRed, colorReset)
+			} else {
+				fmt.Printf(" (optional, leave blank to skip)")
+			}
+			typeLabel := strings.Join(schemaTypes(fieldSchemaObj), "|")
+			if typeLabel == "" {
+				typeLabel = "any"
+			}
+			fmt.Printf(" [%s]\n", typeLabel)
+
+			// Objects and arrays can't be skipped with a blank answer, so ask first
+			if !isRequired && (fieldType == "object" || fieldType == "array") &&
+				!confirmAction(fmt.Sprintf("Include optional field '%s'", fieldName)) {
+				continue
 			}
-			fmt.Printf(" [%s]\n", fieldType)
 
 			// Handle field based on its type
 			var value interface{}
@@ -517,20 +1108,20 @@ func buildInputInteractivelyFromSchema(schema map[string]interface{}) interface{
 				value = buildArrayInteractively(items)
 
 			case "string":
-				value = promptForInput(fmt.Sprintf("Enter value for %s", fieldName), isRequired)
+				value = promptForSchemaString(fmt.Sprintf("Enter value for %s", fieldName), fieldSchemaObj, isRequired)
 
 			case "number", "integer":
 				for {
-					input := promptForInput(fmt.Sprintf("Enter number for %s", fieldName), isRequired)
+					input := promptForInput(fmt.Sprintf("Enter %s for %s", fieldType, fieldName), isRequired)
 					if input == "" && !isRequired {
 						break
 					}
 
-					if num, err := strconv.ParseFloat(input, 64); err == nil {
+					if num, err := parseSchemaNumber(input, fieldType); err == nil {
 						value = num
 						break
 					} else {
-						fmt.Printf("%sError:%s Please enter a valid number\n", colorRed, colorReset)
+						fmt.Printf("%sError:%s %v\n", colorRed, colorReset, err)
 					}
 				}
 
@@ -553,6 +1144,18 @@ func buildInputInteractivelyFromSchema(schema map[string]interface{}) interface{
 					}
 				}
 
+			case "mixed":
+				// Values of several types were seen, so accept any JSON and
+				// fall back to treating the input as a string
+				input := promptForInput(fmt.Sprintf("Enter JSON value for %s", fieldName), isRequired)
+				if input != "" {
+					if parsed, err := parseJSONValue(input); err == nil {
+						value = parsed
+					} else {
+						value = input
+					}
+				}
+
 			default:
 				// Default to string for unknown types
 				value = promptForInput(fmt.Sprintf("Enter value for %s", fieldName), isRequired)
@@ -571,13 +1174,49 @@ func buildInputInteractivelyFromSchema(schema map[string]interface{}) interface{
 	return buildInputInteractively(nil)
 }
 
+// chooseSchemaVariant asks which of several alternative input structures to build
+func chooseSchemaVariant(variants []interface{}) map[string]interface{} {
+	fmt.Println("Executions of this workflow were started with different input structures:")
+	for i, variant := range variants {
+		fmt.Printf("%d. %s\n", i+1, describeSchemaVariant(variant))
+	}
+
+	for {
+		input := promptForInput(fmt.Sprintf("Choose a structure (1-%d)", len(variants)), true)
+		choice, err := strconv.Atoi(input)
+		if err == nil && choice >= 1 && choice <= len(variants) {
+			if variant, ok := variants[choice-1].(map[string]interface{}); ok {
+				return variant
+			}
+			return nil
+		}
+		fmt.Printf("%sError:%s Please enter a number between 1 and %d\n", colorRed, colorReset, len(variants))
+	}
+}
+
+// describeSchemaVariant summarizes a schema by its fields, or by its type
+func describeSchemaVariant(variant interface{}) string {
+	schema, _ := variant.(map[string]interface{})
+	properties, ok := schema["properties"].(map[string]interface{})
+	if !ok {
+		return strings.Join(schemaTypes(schema), "|")
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return "object with " + strings.Join(names, ", ")
+}
+
 // buildArrayInteractively prompts the user to build an array item by item
 func buildArrayInteractively(itemSchema map[string]interface{}) []interface{} {
 	result := make([]interface{}, 0)
 
 	fmt.Printf("Building array (enter empty value when done):\n")
 
-	itemType, _ := itemSchema["type"].(string)
+	itemType, _ := schemaValueType(itemSchema)
 
 	for i := 1; ; i++ {
 		fmt.Printf("Item %d:\n", i)
@@ -609,7 +1248,7 @@ func buildArrayInteractively(itemSchema map[string]interface{}) []interface{} {
 			}
 
 		case "string":
-			input := promptForInput("Enter value (or empty to finish)", false)
+			input := promptForSchemaString("Enter value (or empty to finish)", itemSchema, false)
 			if input == "" {
 				done = true
 			} else {
@@ -617,14 +1256,14 @@ func buildArrayInteractively(itemSchema map[string]interface{}) []interface{} {
 			}
 
 		case "number", "integer":
-			input := promptForInput("Enter number (or empty to finish)", false)
+			input := promptForInput(fmt.Sprintf("Enter %s (or empty to finish)", itemType), false)
 			if input == "" {
 				done = true
 			} else {
-				if num, err := strconv.ParseFloat(input, 64); err == nil {
+				if num, err := parseSchemaNumber(input, itemType); err == nil {
 					item = num
 				} else {
-					fmt.Printf("%sError:%s Not a valid number, skipping\n", colorRed, colorReset)
+					fmt.Printf("%sError:%s %v, skipping\n", colorRed, colorReset, err)
 					continue
 				}
 			}
@@ -847,6 +1486,75 @@ func promptForInput(prompt string, required bool) string {
 	}
 }
 
+// promptForSchemaString asks for a string value, offering the values the
+// schema lists as choices and checking the input against its format
+func promptForSchemaString(prompt string, schema map[string]interface{}, required bool) string {
+	var choices []string
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		for _, value := range enum {
+			if choice, ok := value.(string); ok {
+				choices = append(choices, choice)
+			}
+		}
+	}
+	format, _ := schema["format"].(string)
+
+	if len(choices) > 0 {
+		fmt.Println("Values seen in earlier executions:")
+		for i, choice := range choices {
+			fmt.Printf("  %d. %s\n", i+1, choice)
+		}
+		prompt += fmt.Sprintf(" (1-%d or a value)", len(choices))
+	} else if format != "" {
+		prompt += fmt.Sprintf(" (%s)", format)
+	}
+
+	for {
+		input := promptForInput(prompt, required)
+		if input == "" {
+			return ""
+		}
+
+		if len(choices) > 0 {
+			if n, err := strconv.Atoi(input); err == nil && n >= 1 && n <= len(choices) {
+				return choices[n-1]
+			}
+			// The inferred values may not be all the valid ones
+			known := false
+			for _, choice := range choices {
+				known = known || choice == input
+			}
+			if !known && !confirmAction(fmt.Sprintf("'%s' wasn't seen in earlier executions; use it anyway", input)) {
+				continue
+			}
+			return input
+		}
+
+		if format != "" && !matchesStringFormat(format, input) {
+			fmt.Printf("%sError:%s Please enter a valid %s\n", colorRed, colorReset, format)
+			continue
+		}
+		return input
+	}
+}
+
+// parseSchemaNumber parses input as an integer or number schema value
+func parseSchemaNumber(input, valueType string) (interface{}, error) {
+	if valueType == "integer" {
+		num, err := strconv.ParseInt(input, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a whole number")
+		}
+		return num, nil
+	}
+
+	num, err := strconv.ParseFloat(input, 64)
+	if err != nil {
+		return nil, fmt.Errorf("expected a number")
+	}
+	return num, nil
+}
+
 // confirmAction asks the user to confirm an action
 func confirmAction(prompt string) bool {
 	scanner := bufio.NewScanner(os.Stdin)
@@ -875,42 +1583,149 @@ func signalWorkflow(c *cli.Context, config TemporalConfig) error {
 	defer cancel()
 
 	signalName := c.String("signal-name")
-	inputFlag := c.String("input")
-	var input string
-
-	// Check if input should be read from stdin
-	if inputFlag == "-" {
-		fmt.Println("Reading signal input from stdin...")
-		scanner := bufio.NewScanner(os.Stdin)
-		var inputBuilder strings.Builder
-		for scanner.Scan() {
-			inputBuilder.WriteString(scanner.Text())
-		}
-		if err := scanner.Err(); err != nil {
-			return fmt.Errorf("error reading from stdin: %w", err)
-		}
-		input = inputBuilder.String()
-
-		// If input is empty, provide a warning
-		if strings.TrimSpace(input) == "" {
-			fmt.Println("Warning: Empty input received from stdin")
-			input = "{}" // Fallback to empty JSON object
-		}
-	} else {
-		// Use the input provided in the flag
-		input = inputFlag
+	args, err := parseInputArgs(config, c.StringSlice("input"), c.Bool("input-args"), "signal input")
+	if err != nil {
+		return err
 	}
 
 	// Signal the workflow
-	err = temporalClient.SignalWorkflow(ctx, config.WorkflowID, "", signalName, []byte(input))
+	err = signalWithArgs(ctx, temporalClient, config, config.WorkflowID, config.RunID, signalName, args)
 	if err != nil {
 		return fmt.Errorf("failed to signal workflow: %w", err)
 	}
 
+	if isStructuredOutput(config) {
+		return printStructured(config, signalResult{
+			WorkflowID: config.WorkflowID,
+			RunID:      config.RunID,
+			SignalName: signalName,
+		})
+	}
+
 	fmt.Printf("Signal '%s' sent to workflow ID: %s\n", signalName, config.WorkflowID)
 	return nil
 }
 
+// signalResult is the structured output of the signal command
+type signalResult struct {
+	WorkflowID string `json:"workflowId" yaml:"workflowId"`
+	RunID      string `json:"runId,omitempty" yaml:"runId,omitempty"`
+	SignalName string `json:"signalName" yaml:"signalName"`
+}
+
+// signalWithStartWorkflow atomically signals a workflow, starting a new run
+// first if no run with the workflow ID is open
+func signalWithStartWorkflow(c *cli.Context, config TemporalConfig) error {
+	workflowID := c.String("workflow-id")
+	if workflowID == "" {
+		workflowID = config.WorkflowID
+	}
+	if workflowID == "" {
+		return fmt.Errorf("workflow ID is required for signal-with-start")
+	}
+
+	workflowType := c.String("workflow-type")
+	signalName := c.String("signal-name")
+
+	// Only one input may come from stdin
+	if c.String("signal-input") == "-" {
+		for _, input := range c.StringSlice("input") {
+			if input == "-" {
+				return fmt.Errorf("only one of --input and --signal-input can be read from stdin")
+			}
+		}
+	}
+
+	args, err := parseInputArgs(config, c.StringSlice("input"), c.Bool("input-args"), "input")
+	if err != nil {
+		return err
+	}
+
+	signalArgs, err := parseInputArgs(config, []string{c.String("signal-input")}, false, "signal input")
+	if err != nil {
+		return err
+	}
+
+	workflowOptions, err := buildStartWorkflowOptions(c, config, workflowID)
+	if err != nil {
+		return err
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Create a context with timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Remember the latest run, if any, so we can tell whether a new one was
+	// started. Only a missing workflow means there is none; guessing after
+	// other errors could report a new run for one that already existed.
+	var previous *workflow.WorkflowExecutionInfo
+	resp, err := temporalClient.DescribeWorkflowExecution(ctx, workflowID, "")
+	var notFound *serviceerror.NotFound
+	switch {
+	case err == nil:
+		previous = resp.WorkflowExecutionInfo
+	case !errors.As(err, &notFound):
+		return fmt.Errorf("failed to check for a running workflow: %w", err)
+	}
+
+	we, err := temporalClient.SignalWithStartWorkflow(ctx, workflowID, signalName, signalArgs[0],
+		workflowOptions, workflowType, args...)
+	if err != nil {
+		return fmt.Errorf("failed to signal-with-start workflow: %w", err)
+	}
+
+	result := signalWithStartResult{
+		WorkflowID:   we.GetID(),
+		RunID:        we.GetRunID(),
+		WorkflowType: workflowType,
+		SignalName:   signalName,
+		Started:      signalWithStartStarted(previous, we.GetRunID()),
+		Options:      newStartOptionsSummary(workflowOptions),
+	}
+
+	if isStructuredOutput(config) {
+		return printStructured(config, result)
+	}
+
+	fmt.Printf("Signal '%s' sent to workflow ID: %s\n", signalName, result.WorkflowID)
+	fmt.Printf("Run ID: %s\n", result.RunID)
+	if result.Started {
+		fmt.Printf("%sStarted a new %s run%s\n", colorGreen, workflowType, colorReset)
+		printStartOptions(result.Options)
+	} else {
+		fmt.Println("Signaled the running workflow; no new run was started")
+	}
+
+	return nil
+}
+
+// signalWithStartStarted reports whether SignalWithStart started runID rather
+// than signaling the run that was open before it. Another client starting the
+// workflow in between can still make a run look new.
+func signalWithStartStarted(previous *workflow.WorkflowExecutionInfo, runID string) bool {
+	// Closed runs can't be signaled, so the run must have been started
+	if previous == nil || previous.GetStatus() != enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		return true
+	}
+	return previous.GetExecution().GetRunId() != runID
+}
+
+// signalWithStartResult is the structured output of the signal-with-start command
+type signalWithStartResult struct {
+	WorkflowID   string              `json:"workflowId" yaml:"workflowId"`
+	RunID        string              `json:"runId" yaml:"runId"`
+	WorkflowType string              `json:"workflowType" yaml:"workflowType"`
+	SignalName   string              `json:"signalName" yaml:"signalName"`
+	Started      bool                `json:"started" yaml:"started"`
+	Options      startOptionsSummary `json:"options" yaml:"options"`
+}
+
 // queryWorkflow queries a workflow
 func queryWorkflow(c *cli.Context, config TemporalConfig) error {
 	if config.WorkflowID == "" {
@@ -928,34 +1743,13 @@ func queryWorkflow(c *cli.Context, config TemporalConfig) error {
 	defer cancel()
 
 	queryType := c.String("query-type")
-	argsFlag := c.String("args")
-	var args string
-
-	// Check if args should be read from stdin
-	if argsFlag == "-" {
-		fmt.Println("Reading query args from stdin...")
-		scanner := bufio.NewScanner(os.Stdin)
-		var argsBuilder strings.Builder
-		for scanner.Scan() {
-			argsBuilder.WriteString(scanner.Text())
-		}
-		if err := scanner.Err(); err != nil {
-			return fmt.Errorf("error reading from stdin: %w", err)
-		}
-		args = argsBuilder.String()
-
-		// If args is empty, provide a warning
-		if strings.TrimSpace(args) == "" {
-			fmt.Println("Warning: Empty args received from stdin")
-			args = "{}" // Fallback to empty JSON object
-		}
-	} else {
-		// Use the args provided in the flag
-		args = argsFlag
+	args, err := parseInputArgs(config, c.StringSlice("args"), c.Bool("input-args"), "query args")
+	if err != nil {
+		return err
 	}
 
 	// Query the workflow
-	response, err := temporalClient.QueryWorkflow(ctx, config.WorkflowID, "", queryType, []byte(args))
+	response, err := temporalClient.QueryWorkflow(ctx, config.WorkflowID, config.RunID, queryType, args...)
 	if err != nil {
 		return fmt.Errorf("failed to query workflow: %w", err)
 	}
@@ -966,10 +1760,25 @@ func queryWorkflow(c *cli.Context, config TemporalConfig) error {
 		return fmt.Errorf("failed to parse query result: %w", err)
 	}
 
-	fmt.Printf("Query result: %v\n", result)
+	if isStructuredOutput(config) {
+		return printStructured(config, queryResult{
+			WorkflowID: config.WorkflowID,
+			QueryType:  queryType,
+			Result:     result,
+		})
+	}
+
+	fmt.Printf("Query result:\n%s\n", formatJSON(result))
 	return nil
 }
 
+// queryResult is the structured output of the query command
+type queryResult struct {
+	WorkflowID string      `json:"workflowId" yaml:"workflowId"`
+	QueryType  string      `json:"queryType" yaml:"queryType"`
+	Result     interface{} `json:"result" yaml:"result"`
+}
+
 // describeWorkflow gets detailed information about a specific workflow
 func describeWorkflow(c *cli.Context, config TemporalConfig) error {
 	if config.WorkflowID == "" {
@@ -987,6 +1796,9 @@ func describeWorkflow(c *cli.Context, config TemporalConfig) error {
 	defer cancel()
 
 	runID := c.String("run-id")
+	if runID == "" {
+		runID = config.RunID
+	}
 
 	// Get workflow execution details
 	resp, err := temporalClient.DescribeWorkflowExecution(ctx, config.WorkflowID, runID)
@@ -994,6 +1806,33 @@ func describeWorkflow(c *cli.Context, config TemporalConfig) error {
 		return fmt.Errorf("failed to describe workflow: %w", err)
 	}
 
+	// Fetch workflow history to get input data
+	iter := temporalClient.GetWorkflowHistory(ctx, config.WorkflowID, runID, false, 0)
+	var startedEvent *history.HistoryEvent
+	var startedEventFound bool
+
+	for iter.HasNext() {
+		event, err := iter.Next()
+		if err != nil {
+			return fmt.Errorf("failed to get workflow history: %w", err)
+		}
+
+		// Look for the workflow started event (always the first event)
+		if event.GetEventType() == enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED {
+			startedEvent = event
+			startedEventFound = true
+			break
+		}
+	}
+
+	if isStructuredOutput(config) {
+		var input []interface{}
+		if startedEventFound && startedEvent != nil {
+			input = decodePayloads(startedEvent.GetWorkflowExecutionStartedEventAttributes().GetInput())
+		}
+		return printStructured(config, newWorkflowDescription(resp, input))
+	}
+
 	// Print execution details
 	fmt.Println(fmt.Sprintf("%s%s==== Workflow Details ====%s", colorBold, colorBlue, colorReset))
 	execution := resp.WorkflowExecutionInfo
@@ -1013,23 +1852,14 @@ func describeWorkflow(c *cli.Context, config TemporalConfig) error {
 	fmt.Printf("History Length: %d\n", execution.HistoryLength)
 	fmt.Printf("Execution Time: %v\n", execution.ExecutionTime)
 
-	// Fetch workflow history to get input data
-	iter := temporalClient.GetWorkflowHistory(ctx, config.WorkflowID, runID, false, 0)
-	var startedEvent *history.HistoryEvent
-	var startedEventFound bool
-
-	for iter.HasNext() {
-		event, err := iter.Next()
-		if err != nil {
-			return fmt.Errorf("failed to get workflow history: %w", err)
-		}
+	if searchAttributes := decodePayloadMap(execution.GetSearchAttributes().GetIndexedFields()); searchAttributes != nil {
+		fmt.Println(fmt.Sprintf("\n%s%s==== Search Attributes ====%s", colorBold, colorYellow, colorReset))
+		printPayloadMap(searchAttributes)
+	}
 
-		// Look for the workflow started event (always the first event)
-		if event.GetEventType() == enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED {
-			startedEvent = event
-			startedEventFound = true
-			break
-		}
+	if memo := decodePayloadMap(execution.GetMemo().GetFields()); memo != nil {
+		fmt.Println(fmt.Sprintf("\n%s%s==== Memo ====%s", colorBold, colorYellow, colorReset))
+		printPayloadMap(memo)
 	}
 
 	// Display input if found
@@ -1102,6 +1932,76 @@ func describeWorkflow(c *cli.Context, config TemporalConfig) error {
 	return nil
 }
 
+// workflowDescription is the structured output of the describe command
+type workflowDescription struct {
+	workflowSummary   `yaml:",inline"`
+	HistoryLength     int64                  `json:"historyLength" yaml:"historyLength"`
+	ExecutionTime     *time.Time             `json:"executionTime,omitempty" yaml:"executionTime,omitempty"`
+	Input             []interface{}          `json:"input" yaml:"input"`
+	SearchAttributes  map[string]interface{} `json:"searchAttributes,omitempty" yaml:"searchAttributes,omitempty"`
+	Memo              map[string]interface{} `json:"memo,omitempty" yaml:"memo,omitempty"`
+	PendingActivities []pendingActivity      `json:"pendingActivities" yaml:"pendingActivities"`
+	PendingChildren   []pendingChild         `json:"pendingChildren" yaml:"pendingChildren"`
+}
+
+// pendingActivity is the structured form of a pending activity
+type pendingActivity struct {
+	ActivityID        string     `json:"activityId" yaml:"activityId"`
+	Type              string     `json:"type" yaml:"type"`
+	State             string     `json:"state" yaml:"state"`
+	Attempt           int32      `json:"attempt" yaml:"attempt"`
+	ScheduledTime     *time.Time `json:"scheduledTime,omitempty" yaml:"scheduledTime,omitempty"`
+	LastHeartbeatTime *time.Time `json:"lastHeartbeatTime,omitempty" yaml:"lastHeartbeatTime,omitempty"`
+}
+
+// pendingChild is the structured form of a pending child workflow
+type pendingChild struct {
+	WorkflowID        string `json:"workflowId" yaml:"workflowId"`
+	RunID             string `json:"runId" yaml:"runId"`
+	Type              string `json:"type" yaml:"type"`
+	ParentClosePolicy string `json:"parentClosePolicy" yaml:"parentClosePolicy"`
+}
+
+// newWorkflowDescription builds the structured output of the describe command
+func newWorkflowDescription(resp *workflowservice.DescribeWorkflowExecutionResponse, input []interface{}) workflowDescription {
+	execution := resp.WorkflowExecutionInfo
+	description := workflowDescription{
+		workflowSummary:   newWorkflowSummary(execution),
+		HistoryLength:     execution.HistoryLength,
+		ExecutionTime:     execution.ExecutionTime,
+		Input:             input,
+		SearchAttributes:  decodePayloadMap(execution.GetSearchAttributes().GetIndexedFields()),
+		Memo:              decodePayloadMap(execution.GetMemo().GetFields()),
+		PendingActivities: []pendingActivity{},
+		PendingChildren:   []pendingChild{},
+	}
+	if description.Input == nil {
+		description.Input = []interface{}{}
+	}
+
+	for _, activity := range resp.PendingActivities {
+		description.PendingActivities = append(description.PendingActivities, pendingActivity{
+			ActivityID:        activity.ActivityId,
+			Type:              activity.ActivityType.GetName(),
+			State:             activity.State.String(),
+			Attempt:           activity.Attempt,
+			ScheduledTime:     activity.ScheduledTime,
+			LastHeartbeatTime: activity.LastHeartbeatTime,
+		})
+	}
+
+	for _, child := range resp.PendingChildren {
+		description.PendingChildren = append(description.PendingChildren, pendingChild{
+			WorkflowID:        child.WorkflowId,
+			RunID:             child.RunId,
+			Type:              child.WorkflowTypeName,
+			ParentClosePolicy: child.ParentClosePolicy.String(),
+		})
+	}
+
+	return description
+}
+
 // inferWorkflowParams tries to infer the parameter structure for a workflow type
 // by examining past executions of that type
 func inferWorkflowParams(c *cli.Context, config TemporalConfig) error {
@@ -1111,114 +2011,142 @@ func inferWorkflowParams(c *cli.Context, config TemporalConfig) error {
 	}
 	defer temporalClient.Close()
 
-	// Create a context with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	// Ctrl-C stops fetching more histories
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 
 	workflowType := c.String("workflow-type")
 	limit := c.Int("limit")
 	outputAsJSONSchema := c.Bool("json-schema")
 	rawOutput := c.Bool("raw")
 
-	if !rawOutput {
-		fmt.Printf("Inferring parameter structure for workflow type: %s%s%s\n",
-			colorBold, workflowType, colorReset)
+	concurrency := c.Int("concurrency")
+	if concurrency < 1 {
+		return fmt.Errorf("--concurrency must be at least 1")
 	}
 
-	// Find recent workflows of this type
-	query := fmt.Sprintf("WorkflowType='%s'", workflowType)
-	listRequest := &workflowservice.ListWorkflowExecutionsRequest{
-		Query: query,
+	kind := strings.ToLower(c.String("kind"))
+	if _, ok := inferKindLabels[kind]; !ok {
+		return fmt.Errorf("unknown --kind '%s' (valid kinds: %s)", kind, strings.Join(inferKinds, ", "))
+	}
+
+	emit := ""
+	if c.IsSet("emit") {
+		language, ok := lookupEmitLanguage(c.String("emit"))
+		if !ok {
+			return fmt.Errorf("unknown --emit language '%s' (valid languages: %s)",
+				c.String("emit"), strings.Join(emitLanguages, ", "))
+		}
+		if outputAsJSONSchema {
+			return fmt.Errorf("use either --emit or --json-schema, not both")
+		}
+		emit = language
 	}
 
-	resp, err := temporalClient.ListWorkflow(ctx, listRequest)
+	query, err := buildInferQuery(c, workflowType)
 	if err != nil {
-		return fmt.Errorf("failed to list workflows: %w", err)
+		return err
 	}
 
-	if len(resp.Executions) == 0 {
-		return fmt.Errorf("no workflows of type '%s' found", workflowType)
+	// Progress messages would corrupt raw, structured or generated output
+	quiet := rawOutput || isStructuredOutput(config) || emit != ""
+
+	if !quiet {
+		if kind == inferKindInput {
+			fmt.Printf("Inferring parameter structure for workflow type: %s%s%s\n",
+				colorBold, workflowType, colorReset)
+		} else {
+			fmt.Printf("Inferring %s payload structures for workflow type: %s%s%s\n",
+				kind, colorBold, workflowType, colorReset)
+		}
 	}
 
-	if !rawOutput {
-		fmt.Printf("Found %d workflow executions\n", len(resp.Executions))
-		fmt.Println("Analyzing recent executions to infer parameter structure...\n")
+	// Find workflows of this type, following every page so that the sample
+	// can be drawn from the whole period rather than the latest runs
+	listCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	candidates, more, err := listWorkflowExecutions(listCtx, temporalClient, query, inferMaxCandidates)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("failed to list workflows: %w", err)
 	}
 
-	// Limit the number of workflows we examine
-	numToExamine := limit
-	if len(resp.Executions) < numToExamine {
-		numToExamine = len(resp.Executions)
+	if len(candidates) == 0 {
+		return fmt.Errorf("no workflows found matching %s", query)
 	}
 
-	// Map to track distinct parameter structures
-	paramStructures := make(map[string]interface{})
-	paramExamples := make(map[string]interface{})
+	executions := spreadSample(candidates, limit)
 
-	// Examine workflows to extract parameter structures
-	for _, execution := range resp.Executions[:numToExamine] {
-		workflowID := execution.Execution.WorkflowId
-		runID := execution.Execution.RunId
+	if !quiet {
+		fmt.Printf("Found %d workflow executions\n", len(candidates))
+		if more {
+			fmt.Printf("%sNote:%s More workflows match; sampling from the most recent %d. Use --since or --query to choose a period.\n",
+				colorYellow, colorReset, len(candidates))
+		}
+		fmt.Printf("Analyzing %d executions spread across them to infer parameter structure...\n\n", len(executions))
+	}
 
-		if !rawOutput {
-			fmt.Printf("Examining workflow ID: %s (Run ID: %s)\n", workflowID, runID)
+	// Examine workflows to extract parameter structures
+	results := fetchInputSamples(ctx, temporalClient, executions, kind, concurrency)
+	for _, result := range results {
+		if !quiet {
+			fmt.Printf("Examining workflow ID: %s (Run ID: %s)\n", result.WorkflowID, result.RunID)
 		}
 
-		// Get workflow history to find start event
-		iter := temporalClient.GetWorkflowHistory(ctx, workflowID, runID, false, 0)
+		if result.Err != nil {
+			if !quiet {
+				fmt.Printf("  %sWarning:%s Could not fetch history: %v\n", colorYellow, colorReset, result.Err)
+			}
+			continue
+		}
 
-		// Look for first event (workflow started)
-		for iter.HasNext() {
-			event, err := iter.Next()
-			if err != nil {
-				if !rawOutput {
-					fmt.Printf("  %sWarning:%s Could not fetch history: %v\n", colorYellow, colorReset, err)
+		if kind != inferKindInput {
+			if !quiet {
+				fmt.Printf("  Found %d %s payloads\n", len(result.Values), kind)
+				for _, data := range result.Invalid {
+					fmt.Printf("  %sWarning:%s Payload is not valid JSON: %v\n", colorYellow, colorReset, data)
 				}
-				break
 			}
+			continue
+		}
 
-			if event.GetEventType() == enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED {
-				startedAttrs := event.GetWorkflowExecutionStartedEventAttributes()
-				if startedAttrs != nil && startedAttrs.Input != nil {
-					payloads := startedAttrs.Input.GetPayloads()
-					if len(payloads) > 0 {
-						for j, payload := range payloads {
-							data := payload.GetData()
-							if len(data) > 0 {
-								// Try to parse as JSON
-								var jsonObj interface{}
-								if err := json.Unmarshal(data, &jsonObj); err == nil {
-									// Get the structure (just keys for maps, types for other values)
-									structureJSON := getStructureJSON(jsonObj)
-									structureStr := fmt.Sprintf("%v", structureJSON)
-
-									// Store unique structures
-									if _, exists := paramStructures[structureStr]; !exists {
-										paramStructures[structureStr] = structureJSON
-										paramExamples[structureStr] = jsonObj
-									}
-
-									if !rawOutput {
-										fmt.Printf("  Found parameter structure (payload %d)\n", j+1)
-									}
-								} else if !rawOutput {
-									fmt.Printf("  %sWarning:%s Parameter is not valid JSON: %v\n",
-										colorYellow, colorReset, string(data))
-								}
-							}
-						}
-					} else if !rawOutput {
-						fmt.Printf("  No input parameters found\n")
-					}
-				}
-				break // Only need to check the first event
+		if !quiet {
+			for j := range result.Values {
+				fmt.Printf("  Found parameter structure (payload %d)\n", j+1)
+			}
+			for _, data := range result.Invalid {
+				fmt.Printf("  %sWarning:%s Parameter is not valid JSON: %v\n", colorYellow, colorReset, data)
+			}
+			if result.Payloads == 0 {
+				fmt.Printf("  No input parameters found\n")
 			}
 		}
 	}
 
+	if ctx.Err() != nil {
+		return fmt.Errorf("inference interrupted")
+	}
+
+	if emit != "" {
+		return emitInferredTypes(emit, c.String("package"), kind, workflowType, results)
+	}
+
+	// Other kinds are grouped by signal, activity or update name
+	if kind != inferKindInput {
+		return inferByName(config, kind, workflowType, results, outputAsJSONSchema, rawOutput)
+	}
+
+	// Each argument position has its own schema and structures
+	groups := groupValuesByName(results)
+
 	// Display the results based on the requested format
-	if len(paramStructures) == 0 {
-		if !rawOutput {
+	if len(groups) == 0 {
+		if isStructuredOutput(config) && !outputAsJSONSchema {
+			return printStructured(config, inferResult{
+				WorkflowType: workflowType,
+				Structures:   []interface{}{},
+			})
+		}
+		if !quiet {
 			fmt.Printf("\n%s%s==== No Parameter Structures Found ====%s\n",
 				colorBold, colorRed, colorReset)
 			fmt.Println("Could not determine parameter structure from the examined workflows.")
@@ -1231,35 +2159,18 @@ func inferWorkflowParams(c *cli.Context, config TemporalConfig) error {
 	}
 
 	if outputAsJSONSchema {
-		// Generate JSONSchema from the first example we found
-		// (or combine multiple schemas if we detected multiple patterns)
-		var combinedSchema map[string]interface{}
-
-		if len(paramExamples) == 1 {
-			// If we only have one structure, use it directly
-			for _, example := range paramExamples {
-				combinedSchema = generateJSONSchema(example, workflowType)
-				break
-			}
-		} else {
-			// For multiple structures, create a schema with oneOf
-			combinedSchema = map[string]interface{}{
-				"$schema":     "http://json-schema.org/draft-07/schema#",
-				"title":       fmt.Sprintf("%s Parameters", workflowType),
-				"description": fmt.Sprintf("Parameter schema for %s workflow", workflowType),
-				"oneOf":       []interface{}{},
-			}
+		// Merge the parameters at each position into one schema, so that
+		// fields missing from some of them are optional and differing types
+		// become unions
+		combinedSchema := argumentsSchema(groups)
+		combinedSchema["title"] = fmt.Sprintf("%s Parameters", workflowType)
+		combinedSchema["description"] = fmt.Sprintf("Parameter schema for %s workflow", workflowType)
 
-			oneOf := combinedSchema["oneOf"].([]interface{})
-			for _, example := range paramExamples {
-				schema := generateJSONSchema(example, "")
-				delete(schema, "$schema") // Remove the $schema field from sub-schemas
-				oneOf = append(oneOf, schema)
-			}
-			combinedSchema["oneOf"] = oneOf
+		// Output the JSONSchema
+		if isStructuredOutput(config) {
+			return printStructured(config, combinedSchema)
 		}
 
-		// Output the JSONSchema
 		var output []byte
 		var err error
 
@@ -1274,20 +2185,40 @@ func inferWorkflowParams(c *cli.Context, config TemporalConfig) error {
 		}
 
 		fmt.Println(string(output))
-	} else if !rawOutput {
+	} else if isStructuredOutput(config) {
+		// A workflow taking several arguments gets one result per position
+		if groups[0].Args == 1 {
+			return printStructured(config, inferResult{
+				WorkflowType: workflowType,
+				Structures:   groups[0].Examples,
+			})
+		}
+		inferred := make([]inferResult, 0, len(groups))
+		for _, group := range groups {
+			inferred = append(inferred, inferResult{
+				WorkflowType: workflowType,
+				Arg:          group.Arg + 1,
+				Structures:   group.Examples,
+			})
+		}
+		return printStructured(config, inferred)
+	} else if !quiet {
 		// Display the examples in the original format
 		fmt.Printf("\n%s%s==== Inferred Parameter Structures ====%s\n",
 			colorBold, colorGreen, colorReset)
 
-		fmt.Printf("Found %d distinct parameter structures:\n\n", len(paramExamples))
+		for _, group := range groups {
+			if group.Args > 1 {
+				fmt.Printf("\n%sArgument %d:%s ", colorBold, group.Arg+1, colorReset)
+			}
+			fmt.Printf("Found %d distinct parameter structures:\n\n", len(group.Examples))
 
-		i := 1
-		for _, example := range paramExamples {
-			fmt.Printf("%sStructure %d:%s\n", colorBold, i, colorReset)
-			jsonBytes, _ := json.MarshalIndent(example, "", "  ")
-			fmt.Println(string(jsonBytes))
-			fmt.Println()
-			i++
+			for i, example := range group.Examples {
+				fmt.Printf("%sStructure %d:%s\n", colorBold, i+1, colorReset)
+				jsonBytes, _ := json.MarshalIndent(example, "", "  ")
+				fmt.Println(string(jsonBytes))
+				fmt.Println()
+			}
 		}
 
 		fmt.Println("You can use these structures as templates when starting new workflows.")
@@ -1297,52 +2228,24 @@ func inferWorkflowParams(c *cli.Context, config TemporalConfig) error {
 	return nil
 }
 
+// inferResult is the structured output of the infer-params command
+type inferResult struct {
+	WorkflowType string `json:"workflowType" yaml:"workflowType"`
+	Kind         string `json:"kind,omitempty" yaml:"kind,omitempty"`
+	Name         string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Arg is the 1-based argument position, for names sent several arguments
+	Arg        int           `json:"arg,omitempty" yaml:"arg,omitempty"`
+	Structures []interface{} `json:"structures" yaml:"structures"`
+}
+
 // generateJSONSchema converts an example object to a JSONSchema representation
 func generateJSONSchema(example interface{}, title string) map[string]interface{} {
-	schema := map[string]interface{}{
-		"$schema": "http://json-schema.org/draft-07/schema#",
-		"type":    getJSONType(example),
-	}
-
-	if title != "" {
-		schema["title"] = fmt.Sprintf("%s Parameters", title)
-		schema["description"] = fmt.Sprintf("Parameter schema for %s workflow", title)
-	}
-
-	switch v := example.(type) {
-	case map[string]interface{}:
-		properties := make(map[string]interface{})
-		required := []string{}
-
-		for key, val := range v {
-			properties[key] = generateJSONSchema(val, "")
-
-			// Assume all fields are required unless they're null
-			if val != nil {
-				required = append(required, key)
-			}
-		}
-
-		schema["properties"] = properties
-		if len(required) > 0 {
-			schema["required"] = required
-		}
-
-	case []interface{}:
-		if len(v) > 0 {
-			// Use the first item to determine the items schema
-			schema["items"] = generateJSONSchema(v[0], "")
-		} else {
-			schema["items"] = map[string]interface{}{"type": "string"}
-		}
-	}
-
-	return schema
+	return generateMergedJSONSchema([]interface{}{example}, title)
 }
 
 // getJSONType returns the JSON schema type for a given value
-func getJSONType(v interface{}) string {
-	switch v.(type) {
+func getJSONType(value interface{}) string {
+	switch v := value.(type) {
 	case map[string]interface{}:
 		return "object"
 	case []interface{}:
@@ -1350,6 +2253,15 @@ func getJSONType(v interface{}) string {
 	case string:
 		return "string"
 	case float64:
+		// Decoded JSON numbers are always float64, so tell whole ones apart
+		if v == math.Trunc(v) && !math.IsInf(v, 0) {
+			return "integer"
+		}
+		return "number"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
 		return "number"
 	case int:
 		return "integer"
@@ -1376,15 +2288,28 @@ func getStructureJSON(data interface{}) interface{} {
 		return result
 
 	case []interface{}:
-		if len(v) > 0 {
-			// Just use the first element to represent array structure
-			return []interface{}{getStructureJSON(v[0])}
+		if len(v) == 0 {
+			return []interface{}{"empty_array"}
+		}
+
+		// Keep one copy of each distinct element structure
+		var structures []interface{}
+		seen := make(map[string]bool)
+		for _, item := range v {
+			structure := getStructureJSON(item)
+			key := fmt.Sprintf("%v", structure)
+			if !seen[key] {
+				seen[key] = true
+				structures = append(structures, structure)
+			}
 		}
-		return []interface{}{"empty_array"}
+		return structures
 
 	case string:
 		return "string"
 	case float64:
+		// Whole and fractional numbers are the same structure; only the merged
+		// schema tells integers apart
 		return "number"
 	case bool:
 		return "boolean"
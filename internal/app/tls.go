@@ -0,0 +1,60 @@
+package app
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// tlsEnabled reports whether any TLS setting has been configured
+func tlsEnabled(config TemporalConfig) bool {
+	return config.TLS ||
+		config.TLSCACert != "" ||
+		config.TLSCert != "" ||
+		config.TLSKey != "" ||
+		config.TLSServerName != "" ||
+		config.TLSInsecureSkipVerify
+}
+
+// buildTLSConfig creates the TLS configuration used to connect to Temporal.
+// It returns nil when TLS has not been configured.
+func buildTLSConfig(config TemporalConfig) (*tls.Config, error) {
+	if !tlsEnabled(config) {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         config.TLSServerName,
+		InsecureSkipVerify: config.TLSInsecureSkipVerify,
+	}
+
+	// Use a custom CA bundle instead of the system roots if one is provided
+	if config.TLSCACert != "" {
+		caPEM, err := os.ReadFile(config.TLSCACert)
+		if err != nil {
+			return nil, fmt.Errorf("could not read CA certificate: %w", err)
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid certificates found in %s", config.TLSCACert)
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	// Client certificates are only used for mTLS and must come as a pair
+	if config.TLSCert != "" || config.TLSKey != "" {
+		if config.TLSCert == "" || config.TLSKey == "" {
+			return nil, fmt.Errorf("both --tls-cert and --tls-key are required for mTLS")
+		}
+
+		cert, err := tls.LoadX509KeyPair(config.TLSCert, config.TLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
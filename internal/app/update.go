@@ -0,0 +1,159 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	updatepb "go.temporal.io/api/update/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// updateResult is the structured output of the update command
+type updateResult struct {
+	WorkflowID string        `json:"workflowId" yaml:"workflowId"`
+	RunID      string        `json:"runId,omitempty" yaml:"runId,omitempty"`
+	UpdateID   string        `json:"updateId" yaml:"updateId"`
+	UpdateName string        `json:"updateName" yaml:"updateName"`
+	Status     string        `json:"status" yaml:"status"`
+	Result     interface{}   `json:"result,omitempty" yaml:"result,omitempty"`
+	Failure    []failureInfo `json:"failure,omitempty" yaml:"failure,omitempty"`
+}
+
+// updateWorkflow sends an update to a workflow and waits for it to be accepted or completed
+func updateWorkflow(c *cli.Context, config TemporalConfig) error {
+	if config.WorkflowID == "" {
+		return fmt.Errorf("workflow ID is required for updating")
+	}
+
+	var stage enums.UpdateWorkflowExecutionLifecycleStage
+	switch waitFor := c.String("wait-for"); waitFor {
+	case "completed":
+		stage = enums.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED
+	case "accepted":
+		stage = enums.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED
+	default:
+		return fmt.Errorf("unknown --wait-for value '%s' (expected completed or accepted)", waitFor)
+	}
+
+	args, err := parseInputArgs(config, c.StringSlice("input"), c.Bool("input-args"), "input")
+	if err != nil {
+		return err
+	}
+
+	temporalClient, err := getTemporalClient(config)
+	if err != nil {
+		return fmt.Errorf("failed to create Temporal client: %w", err)
+	}
+	defer temporalClient.Close()
+
+	// Update handlers can run for a while, so only time out when asked to
+	ctx := context.Background()
+	if timeout := c.Duration("wait-timeout"); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	updateName := c.String("update-name")
+	handle, err := temporalClient.UpdateWorkflowWithOptions(ctx, &client.UpdateWorkflowWithOptionsRequest{
+		UpdateID:   c.String("update-id"),
+		WorkflowID: config.WorkflowID,
+		RunID:      config.RunID,
+		UpdateName: updateName,
+		Args:       args,
+		WaitPolicy: &updatepb.WaitPolicy{LifecycleStage: stage},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update workflow: %w", err)
+	}
+
+	result := updateResult{
+		WorkflowID: handle.WorkflowID(),
+		RunID:      handle.RunID(),
+		UpdateID:   handle.UpdateID(),
+		UpdateName: updateName,
+	}
+
+	updateErr := awaitUpdate(ctx, handle, stage, &result)
+	if updateErr != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		// The SDK wraps the deadline in its own error, so ask the context
+		return fmt.Errorf("timed out after %v waiting for update result", c.Duration("wait-timeout"))
+	}
+
+	if isStructuredOutput(config) {
+		if err := printStructured(config, result); err != nil {
+			return err
+		}
+	} else {
+		printUpdateResult(result)
+	}
+
+	if updateErr != nil {
+		return fmt.Errorf("update '%s' was rejected or failed", updateName)
+	}
+	return nil
+}
+
+// awaitUpdate waits for an update to reach stage and records its outcome in
+// result. It returns the update's failure if it was rejected or failed.
+func awaitUpdate(ctx context.Context, handle client.WorkflowUpdateHandle,
+	stage enums.UpdateWorkflowExecutionLifecycleStage, result *updateResult) error {
+	// When only waiting for acceptance, the SDK hands back a completed handle
+	// if the update was rejected or finished straight away, and Get on it
+	// returns at once. Other handles would poll for the outcome, which a
+	// canceled context stops, so cancellation means the update was accepted.
+	getCtx := ctx
+	if stage == enums.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED {
+		var cancel context.CancelFunc
+		getCtx, cancel = context.WithCancel(ctx)
+		cancel()
+	}
+
+	var value interface{}
+	err := handle.Get(getCtx, &value)
+	switch {
+	case err == nil:
+		result.Status = "Completed"
+		result.Result = value
+		return nil
+	case stage == enums.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED && isCancellation(err):
+		result.Status = "Accepted"
+		return nil
+	default:
+		result.Status = "Failed"
+		result.Failure = failureChain(err)
+		return err
+	}
+}
+
+// isCancellation reports whether err comes from a canceled context. Calls to
+// the server report it as a Canceled service error rather than wrapping
+// context.Canceled.
+func isCancellation(err error) bool {
+	var canceled *serviceerror.Canceled
+	return errors.Is(err, context.Canceled) || errors.As(err, &canceled)
+}
+
+// printUpdateResult prints the outcome of an update
+func printUpdateResult(result updateResult) {
+	fmt.Printf("Update ID: %s\n", result.UpdateID)
+	fmt.Printf("Workflow ID: %s\n", result.WorkflowID)
+	if result.RunID != "" {
+		fmt.Printf("Run ID: %s\n", result.RunID)
+	}
+
+	switch result.Status {
+	case "Completed":
+		fmt.Printf("%sUpdate '%s' completed%s\n", colorGreen, result.UpdateName, colorReset)
+		fmt.Printf("Result:\n%s\n", formatJSON(result.Result))
+	case "Accepted":
+		fmt.Printf("%sUpdate '%s' accepted%s\n", colorGreen, result.UpdateName, colorReset)
+	default:
+		fmt.Printf("%sUpdate '%s' was rejected or failed%s\n", colorRed, result.UpdateName, colorReset)
+		printFailureChain(result.Failure)
+	}
+}
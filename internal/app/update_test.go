@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+)
+
+// fakeUpdateHandle is a WorkflowUpdateHandle. A pending handle polls for the
+// outcome like the SDK's handles for running updates; other handles return
+// their fixed outcome at once like completed ones.
+type fakeUpdateHandle struct {
+	pending bool
+	// pollErr is what polling returns once the context is done
+	pollErr error
+	value   interface{}
+	err     error
+}
+
+func (h *fakeUpdateHandle) WorkflowID() string { return "order-1" }
+func (h *fakeUpdateHandle) RunID() string      { return "run-1" }
+func (h *fakeUpdateHandle) UpdateID() string   { return "update-1" }
+
+func (h *fakeUpdateHandle) Get(ctx context.Context, valuePtr interface{}) error {
+	if h.pending {
+		<-ctx.Done()
+		if h.pollErr != nil {
+			return h.pollErr
+		}
+		return ctx.Err()
+	}
+	if h.err != nil {
+		return h.err
+	}
+	*valuePtr.(*interface{}) = h.value
+	return nil
+}
+
+func TestAwaitUpdateAccepted(t *testing.T) {
+	stage := enums.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED
+
+	for _, test := range []struct {
+		name    string
+		handle  *fakeUpdateHandle
+		status  string
+		wantErr bool
+	}{
+		{"still running", &fakeUpdateHandle{pending: true}, "Accepted", false},
+		{"still running, polled over gRPC", &fakeUpdateHandle{pending: true, pollErr: serviceerror.NewCanceled("context canceled")}, "Accepted", false},
+		{"rejected", &fakeUpdateHandle{err: errors.New("invalid order")}, "Failed", true},
+		{"completed straight away", &fakeUpdateHandle{value: "shipped"}, "Completed", false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var result updateResult
+			err := awaitUpdate(context.Background(), test.handle, stage, &result)
+			if (err != nil) != test.wantErr {
+				t.Errorf("awaitUpdate returned %v, want error: %v", err, test.wantErr)
+			}
+			if result.Status != test.status {
+				t.Errorf("status = %s, want %s", result.Status, test.status)
+			}
+			if test.wantErr && len(result.Failure) == 0 {
+				t.Errorf("failed update has no failure")
+			}
+		})
+	}
+}
+
+func TestAwaitUpdateCompleted(t *testing.T) {
+	stage := enums.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED
+
+	var result updateResult
+	if err := awaitUpdate(context.Background(), &fakeUpdateHandle{value: "shipped"}, stage, &result); err != nil {
+		t.Fatalf("awaitUpdate returned %v", err)
+	}
+	if result.Status != "Completed" || result.Result != "shipped" {
+		t.Errorf("result = %+v, want Completed with shipped", result)
+	}
+
+	result = updateResult{}
+	failure := errors.New("order already shipped")
+	if err := awaitUpdate(context.Background(), &fakeUpdateHandle{err: failure}, stage, &result); err != failure {
+		t.Fatalf("awaitUpdate returned %v, want %v", err, failure)
+	}
+	if result.Status != "Failed" || len(result.Failure) == 0 {
+		t.Errorf("result = %+v, want Failed with a failure", result)
+	}
+}
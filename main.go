@@ -3,6 +3,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"runtime"
@@ -10,6 +11,7 @@ import (
 
 	_ "net/http/pprof" // Import for side effects (registers HTTP handlers)
 
+	"github.com/urfave/cli/v2"
 	"github.com/weslien/tempural/internal/app"
 )
 
@@ -40,6 +42,12 @@ func main() {
 	// Exit with error code if there was an error
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+
+		// Commands that report a workflow's outcome choose their own exit code
+		var exitErr cli.ExitCoder
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.ExitCode())
+		}
 		os.Exit(1)
 	}
 }